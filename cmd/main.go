@@ -3,17 +3,50 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
 	"net"
+	"net/http"
 	"server/internal/api"
+	"server/internal/domain"
+	"server/internal/gateway"
 	"server/internal/handlers/schema"
+	"server/internal/providers/idempotency"
 	"server/internal/providers/storage"
 	schema_service "server/proto"
+	schema_service_v2 "server/proto/v2"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// Set at build time, e.g.
+// go build -ldflags "-X main.version=1.2.0 -X main.commit=$(git rev-parse HEAD) -X main.buildTime=$(date -u +%FT%TZ)"
+var (
+	version   = "dev"
+	commit    = ""
+	buildTime = ""
 )
 
 func main() {
+	startedAt := time.Now()
+
+	idempotencyTTL := flag.Duration("idempotency-ttl", idempotency.DefaultTTL, "how long idempotency keys are remembered")
+	enableReflection := flag.Bool("reflection", false, "register the gRPC server reflection service")
+	healthInterval := flag.Duration("health-interval", api.DefaultHealthInterval, "how often the storage readiness is checked")
+	httpAddr := flag.String("http-addr", ":8080", "address serving REST/JSON, gRPC-Web and Connect (empty to disable it)")
+	corsOrigins := flag.String("cors-origins", "", "comma-separated origins allowed to call the HTTP address from browsers (* for any)")
+	reviewApprovals := flag.Int("review-approvals", 0, "approvals a schema needs before it is published")
+	reviewRoles := flag.String("review-roles", "", "comma-separated roles that must each be held by an approver")
+	trustedProxy := flag.Bool("trusted-proxy", false, "trust the x-caller-id and x-caller-roles headers, set by an authenticating proxy in front of every listener")
+	flag.Parse()
+
 	// Create a listener on TCP port 50052
 	lis, err := net.Listen("tcp", ":50052")
 	if err != nil {
@@ -25,14 +58,84 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to create storage: %v", err)
 	}
+
+	// Schemas are published once approved under the review policy, if any
+	if *reviewApprovals < 0 {
+		log.Fatalf("Invalid review policy: %d approvals", *reviewApprovals)
+	}
+	reviewPolicy := domain.ReviewPolicy{MinApprovals: *reviewApprovals}
+	for _, role := range strings.Split(*reviewRoles, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			reviewPolicy.RequiredRoles = append(reviewPolicy.RequiredRoles, role)
+		}
+	}
+	storageService.SetReviewPolicy(reviewPolicy)
+
 	schemaHandler := &schema.Schema{StorageProvider: storageService}
 	apiService := &api.SchemaServer{SchemaHandler: schemaHandler}
+	apiServiceV2 := &api.SchemaServerV2{SchemaHandler: schemaHandler}
 
-	// Create a new gRPC server
-	server := grpc.NewServer()
+	// Create a new gRPC server identifying calls, validating requests,
+	// deduplicating retried mutations and translating domain errors into
+	// status codes
+	idempotencyStore := idempotency.NewStore(*idempotencyTTL)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			api.RequestInfoUnaryInterceptor(*trustedProxy),
+			api.ErrorUnaryInterceptor,
+			api.ValidationUnaryInterceptor,
+			api.IdempotencyUnaryInterceptor(idempotencyStore),
+		),
+		grpc.ChainStreamInterceptor(
+			api.RequestInfoStreamInterceptor(*trustedProxy),
+			api.ErrorStreamInterceptor,
+			api.ValidationStreamInterceptor,
+		),
+	)
 
-	// Register the ProcessExecutionService server
+	// Register both versions of the schema service
 	schema_service.RegisterSchemaServiceServer(server, apiService)
+	schema_service_v2.RegisterSchemaServiceServer(server, apiServiceV2)
+
+	// Register the admin service
+	adminService := &api.AdminServer{
+		Build:          api.BuildInfo{Version: version, Commit: commit, BuildTime: buildTime},
+		StorageBackend: storageService.Backend(),
+		StartedAt:      startedAt,
+	}
+	schema_service.RegisterAdminServiceServer(server, adminService)
+
+	// Register the health service, following the storage readiness
+	healthService := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthService)
+	go api.MonitorHealth(context.Background(), healthService, storageService, *healthInterval)
+
+	// Register the reflection service for tools like grpcurl
+	if *enableReflection {
+		reflection.Register(server)
+	}
+
+	// Serve the REST/JSON gateway, gRPC-Web and Connect, calling the gRPC
+	// server over loopback
+	if *httpAddr != "" {
+		conn, err := grpc.Dial("localhost:50052", grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatalf("Failed to connect gateway: %v", err)
+		}
+		gatewayService, err := gateway.New(conn, *trustedProxy)
+		if err != nil {
+			log.Fatalf("Failed to create gateway: %v", err)
+		}
+		var gatewayHandler http.Handler = gatewayService
+		if *corsOrigins != "" {
+			gatewayHandler = gateway.WithCORS(gatewayHandler, strings.Split(*corsOrigins, ","))
+		}
+		go func() {
+			if err := http.ListenAndServe(*httpAddr, gatewayHandler); err != nil {
+				log.Fatalf("Failed to serve gateway: %v", err)
+			}
+		}()
+	}
 
 	// Serve and listen for incoming requests
 	if err := server.Serve(lis); err != nil {
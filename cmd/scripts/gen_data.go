@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"server/internal/domain"
 	"server/internal/handlers/schema"
@@ -21,7 +22,7 @@ func main() {
 		ID:          1,
 		Level:       1,
 		Name:        "Task 1",
-		Status:      "NOT_STARTED",
+		Status:      domain.TaskNotStarted,
 		BlockedBy:   []int64{},
 		Responsible: "Doctor1",
 		TimeLimit:   3600,
@@ -35,7 +36,7 @@ func main() {
 		ID:          3,
 		Level:       2,
 		Name:        "Task 2",
-		Status:      "NOT_STARTED",
+		Status:      domain.TaskNotStarted,
 		BlockedBy:   []int64{},
 		Responsible: "Doctor2",
 		TimeLimit:   7200,
@@ -49,7 +50,7 @@ func main() {
 		ID:          2,
 		Level:       1,
 		Name:        "Task 3",
-		Status:      "NOT_STARTED",
+		Status:      domain.TaskNotStarted,
 		BlockedBy:   []int64{},
 		Responsible: "Doctor3",
 		TimeLimit:   5400,
@@ -59,7 +60,8 @@ func main() {
 		}{Value: "Comment for Task 3"},
 	}
 
-	schemaHandler.Create("Author1", "Schema1", []domain.Task{task1})
-	schemaHandler.Create("Author1", "Schema2", []domain.Task{task1, task2})
-	schemaHandler.Create("Author2", "Schema3", []domain.Task{task2})
+	ctx := context.Background()
+	schemaHandler.Create(ctx, "Author1", "Schema1", []domain.Task{task1}, false)
+	schemaHandler.Create(ctx, "Author1", "Schema2", []domain.Task{task1, task2}, false)
+	schemaHandler.Create(ctx, "Author2", "Schema3", []domain.Task{task2}, false)
 }
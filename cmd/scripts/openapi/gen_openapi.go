@@ -0,0 +1,20 @@
+package main
+
+import (
+	"log"
+	"os"
+	"server/internal/gateway"
+)
+
+func main() {
+	// Generate the OpenAPI document of the REST gateway
+	document, err := gateway.OpenAPI()
+	if err != nil {
+		log.Fatalf("Failed to generate OpenAPI document: %v", err)
+	}
+
+	err = os.WriteFile("./proto/schema_service.openapi.json", append(document, '\n'), 0644)
+	if err != nil {
+		log.Fatalf("Failed to write OpenAPI document: %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	schema_service "server/proto"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// BuildInfo identifies the running binary. An empty commit is filled in from
+// the VCS information embedded by the Go toolchain, when available. The build
+// time has no such fallback: the toolchain only records the commit time.
+type BuildInfo struct {
+	Version   string
+	Commit    string
+	BuildTime string
+}
+
+type AdminServer struct {
+	schema_service.UnimplementedAdminServiceServer
+	Build          BuildInfo
+	StorageBackend string
+	StartedAt      time.Time
+}
+
+func (s *AdminServer) GetServerInfo(ctx context.Context, req *schema_service.GetServerInfoRequest) (*schema_service.GetServerInfoResponse, error) {
+	fmt.Println("START GetServerInfo API")
+
+	build := &schema_service.BuildInfo{
+		Commit:    s.Build.Commit,
+		BuildTime: s.Build.BuildTime,
+		GoVersion: runtime.Version(),
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if build.Commit == "" {
+					build.Commit = setting.Value
+				}
+			case "vcs.time":
+				build.CommitTime = setting.Value
+			case "vcs.modified":
+				build.Modified = setting.Value == "true"
+			}
+		}
+	}
+
+	version := s.Build.Version
+	if version == "" {
+		version = "dev"
+	}
+	uptime := time.Since(s.StartedAt)
+
+	// Create and return gRPC response object
+	response := &schema_service.GetServerInfoResponse{
+		Version:        version,
+		Build:          build,
+		StorageBackend: s.StorageBackend,
+		StartedAt:      &timestamp.Timestamp{Seconds: s.StartedAt.Unix(), Nanos: int32(s.StartedAt.Nanosecond())},
+		Uptime:         &duration.Duration{Seconds: int64(uptime / time.Second), Nanos: int32(uptime % time.Second)},
+	}
+
+	fmt.Println("END GetServerInfo API")
+	return response, nil
+}
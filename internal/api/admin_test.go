@@ -0,0 +1,51 @@
+package api_test
+
+import (
+	"context"
+	"server/internal/api"
+	schema_service "server/proto"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetServerInfo(t *testing.T) {
+	startedAt := time.Now().Add(-time.Minute)
+	adminServer := api.AdminServer{
+		Build:          api.BuildInfo{Version: "1.2.0", Commit: "abc123", BuildTime: "2024-03-01T10:00:00Z"},
+		StorageBackend: "json-file:./data/storage.json",
+		StartedAt:      startedAt,
+	}
+
+	response, err := adminServer.GetServerInfo(context.Background(), &schema_service.GetServerInfoRequest{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if response.Version != "1.2.0" || response.Build.Commit != "abc123" || response.Build.BuildTime != "2024-03-01T10:00:00Z" {
+		t.Errorf("Unexpected build info %+v", response)
+	}
+
+	if !strings.HasPrefix(response.Build.GoVersion, "go") {
+		t.Errorf("Expected Go version, got %q", response.Build.GoVersion)
+	}
+
+	if response.StorageBackend != "json-file:./data/storage.json" || response.StartedAt.AsTime().Unix() != startedAt.Unix() {
+		t.Errorf("Unexpected storage backend or start time %+v", response)
+	}
+
+	if response.Uptime.AsDuration() < time.Minute {
+		t.Errorf("Expected uptime of at least a minute, got %v", response.Uptime.AsDuration())
+	}
+}
+
+func TestGetServerInfoWithoutBuildTime(t *testing.T) {
+	adminServer := api.AdminServer{StartedAt: time.Now()}
+
+	response, err := adminServer.GetServerInfo(context.Background(), &schema_service.GetServerInfoRequest{})
+
+	// The commit time recorded by the toolchain is not a build time
+	if err != nil || response.Build.BuildTime != "" {
+		t.Errorf("Expected no build time, got %+v (%v)", response.Build, err)
+	}
+}
@@ -0,0 +1,296 @@
+package api_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"server/internal/api"
+	"server/internal/domain"
+	"server/internal/handlers/schema"
+	"server/internal/providers/storage"
+	schema_service "server/proto"
+	schema_service_v2 "server/proto/v2"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// The v1 contract records the methods, fields and enum values of
+// alt_team.schema_service as released. Lines may be added to it, never
+// changed or removed; run the tests with -update-contract to add new ones.
+const contractPath = "testdata/v1_contract.txt"
+
+var updateContract = flag.Bool("update-contract", false, "record the current v1 API in "+contractPath)
+
+// describeV1 lists the v1 API one declaration per line.
+func describeV1() []string {
+	var lines []string
+	file := schema_service.File_proto_schema_service_proto
+
+	services := file.Services()
+	for i := 0; i < services.Len(); i++ {
+		methods := services.Get(i).Methods()
+		for j := 0; j < methods.Len(); j++ {
+			m := methods.Get(j)
+			input, output := string(m.Input().FullName()), string(m.Output().FullName())
+			if m.IsStreamingClient() {
+				input = "stream " + input
+			}
+			if m.IsStreamingServer() {
+				output = "stream " + output
+			}
+			lines = append(lines, fmt.Sprintf("rpc %s(%s) returns (%s)", m.FullName(), input, output))
+		}
+	}
+
+	var describeMessages func(messages protoreflect.MessageDescriptors)
+	describeMessages = func(messages protoreflect.MessageDescriptors) {
+		for i := 0; i < messages.Len(); i++ {
+			fields := messages.Get(i).Fields()
+			for j := 0; j < fields.Len(); j++ {
+				lines = append(lines, describeField(fields.Get(j)))
+			}
+			describeMessages(messages.Get(i).Messages())
+		}
+	}
+	describeMessages(file.Messages())
+
+	enums := file.Enums()
+	for i := 0; i < enums.Len(); i++ {
+		values := enums.Get(i).Values()
+		for j := 0; j < values.Len(); j++ {
+			lines = append(lines, fmt.Sprintf("enum %s = %d", values.Get(j).FullName(), values.Get(j).Number()))
+		}
+	}
+	return lines
+}
+
+func describeField(fd protoreflect.FieldDescriptor) string {
+	kind := fd.Kind().String()
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		kind = string(fd.Message().FullName())
+	case protoreflect.EnumKind:
+		kind = string(fd.Enum().FullName())
+	}
+	if fd.IsList() {
+		kind = "repeated " + kind
+	}
+	line := fmt.Sprintf("field %s = %d %s", fd.FullName(), fd.Number(), kind)
+	if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+		line += " oneof " + string(oneof.Name())
+	}
+	return line
+}
+
+func newV1AndV2Servers(t *testing.T) (*api.SchemaServer, *api.SchemaServerV2) {
+	storageService, err := storage.NewStorage("../providers/storage/test_storage.json", true)
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+	handler := &schema.Schema{StorageProvider: storageService}
+	return &api.SchemaServer{SchemaHandler: handler}, &api.SchemaServerV2{SchemaHandler: handler}
+}
+
+func TestV1Contract(t *testing.T) {
+	t.Run("Declarations", func(t *testing.T) {
+		current := describeV1()
+		if *updateContract {
+			if err := os.WriteFile(contractPath, []byte(strings.Join(current, "\n")+"\n"), 0644); err != nil {
+				t.Fatalf("Failed to write the contract: %v", err)
+			}
+		}
+
+		data, err := os.ReadFile(contractPath)
+		if err != nil {
+			t.Fatalf("Failed to read the contract: %v", err)
+		}
+		declared := make(map[string]bool)
+		for _, line := range current {
+			declared[line] = true
+		}
+		for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+			if !declared[line] {
+				t.Errorf("v1 no longer declares: %s", line)
+			}
+		}
+	})
+
+	t.Run("V2FieldsStayHidden", func(t *testing.T) {
+		task := domain.Task{ID: 1, Level: 1, Name: "Task", Status: domain.TaskDone, TimeLimit: 90, Description: "v2 only", Labels: []string{"v2"}}
+		task.Comment.Value = "comment"
+
+		expected := &schema_service.Task{
+			Id:        1,
+			Level:     1,
+			Name:      "Task",
+			Status:    schema_service.TaskStatus_TASK_STATUS_DONE,
+			TimeLimit: 90,
+			Comment:   wrapperspb.String("comment"),
+		}
+		if got := domain.TaskToGRPC(&task); !proto.Equal(got, expected) {
+			t.Errorf("Expected %v, got %v", expected, got)
+		}
+	})
+
+	t.Run("RoundTripThroughV2", func(t *testing.T) {
+		original := []*schema_service.Task{&task1, &task2}
+
+		roundTrip := domain.TasksToGRPC(domain.TasksFromV2(domain.TasksToV2(domain.TasksFromGRPC(original))))
+
+		for i := range original {
+			if !proto.Equal(roundTrip[i], original[i]) {
+				t.Errorf("Expected %v, got %v", original[i], roundTrip[i])
+			}
+		}
+	})
+
+	t.Run("SchemaTimestamps", func(t *testing.T) {
+		created := time.Date(2024, 3, 1, 10, 30, 15, 500, time.UTC)
+
+		grpcSchema := domain.SchemaToGRPC(&domain.Schema{CreatedAt: created, UpdatedAt: created})
+
+		if !grpcSchema.CreatedAt.AsTime().Equal(created) || !grpcSchema.UpdatedAt.AsTime().Equal(created) {
+			t.Errorf("Expected the full creation and update times, got %v and %v", grpcSchema.CreatedAt, grpcSchema.UpdatedAt)
+		}
+		if grpcSchema.DeletedAt == nil || grpcSchema.DeletedAt.Seconds != 0 || grpcSchema.DeletedAt.Nanos != 0 {
+			t.Errorf("Expected an empty deleted_at for a live schema, got %v", grpcSchema.DeletedAt)
+		}
+	})
+
+	t.Run("LevelsFollowDepth", func(t *testing.T) {
+		tasks := []domain.Task{{ID: 1, Level: 3, Children: []domain.Task{{ID: 2}}}}
+
+		v1Tasks, v2Tasks := domain.TasksToGRPC(tasks), domain.TasksToV2(tasks)
+
+		if v1Tasks[0].Level != 1 || v1Tasks[0].Children[0].Level != 2 {
+			t.Errorf("Expected v1 levels 1 and 2, got %v", v1Tasks)
+		}
+		if v2Tasks[0].Level != 1 || v2Tasks[0].Children[0].Level != 2 {
+			t.Errorf("Expected v2 levels 1 and 2, got %v", v2Tasks)
+		}
+	})
+
+	t.Run("SharedSchemas", func(t *testing.T) {
+		v1, v2 := newV1AndV2Servers(t)
+		ctx := context.Background()
+
+		created, err := v2.CreateSchema(ctx, &schema_service_v2.CreateSchemaRequest{
+			AuthorId:   "authorID",
+			SchemaName: "CreatedByV2",
+			Tasks: []*schema_service_v2.Task{
+				{Id: 1, Level: 1, Name: "Task", Status: schema_service_v2.TaskStatus_TASK_STATUS_NOT_STARTED, TimeLimit: durationpb.New(2 * time.Hour), Labels: []string{"v2"}},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		read, err := v1.GetSchemaByID(ctx, &schema_service.GetSchemaByIDRequest{SchemaId: created.SchemaId})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if read.Schema.SchemaName != "CreatedByV2" || read.Schema.Tasks[0].TimeLimit != 120 || read.Schema.Revision != created.Metadata.Revision {
+			t.Errorf("Expected v1 to read the schema in minutes, got %v", read.Schema)
+		}
+
+		if _, err := v1.DeleteSchemaByID(ctx, &schema_service.DeleteSchemaByIDRequest{SchemaId: created.SchemaId}); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, err := v2.GetSchema(ctx, &schema_service_v2.GetSchemaRequest{SchemaId: created.SchemaId}); err == nil {
+			t.Errorf("Expected the schema deleted through v1 to be gone in v2")
+		}
+	})
+
+	t.Run("V1UpdateKeepsV2Fields", func(t *testing.T) {
+		v1, v2 := newV1AndV2Servers(t)
+		ctx := context.Background()
+
+		created, err := v2.CreateSchema(ctx, &schema_service_v2.CreateSchemaRequest{
+			AuthorId:   "authorID",
+			SchemaName: "UpdatedByV1",
+			Tasks: []*schema_service_v2.Task{
+				{Id: 1, Level: 1, Name: "Task", Status: schema_service_v2.TaskStatus_TASK_STATUS_NOT_STARTED, Description: "v2 only", Labels: []string{"v2"},
+					Children: []*schema_service_v2.Task{{Id: 2, Level: 2, Name: "Child", Status: schema_service_v2.TaskStatus_TASK_STATUS_NOT_STARTED, Labels: []string{"child"}}}},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		_, err = v1.UpdateSchema(ctx, &schema_service.UpdateSchemaRequest{
+			SchemaId: created.SchemaId,
+			Tasks: []*schema_service.Task{
+				{Id: 1, Level: 1, Name: "Renamed task", Status: schema_service.TaskStatus_TASK_STATUS_DONE,
+					Children: []*schema_service.Task{{Id: 2, Level: 2, Name: "Child", Status: schema_service.TaskStatus_TASK_STATUS_DONE}}},
+				{Id: 3, Level: 1, Name: "New task", Status: schema_service.TaskStatus_TASK_STATUS_NOT_STARTED},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		read, err := v2.GetSchema(ctx, &schema_service_v2.GetSchemaRequest{SchemaId: created.SchemaId})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		task, child, added := read.Tasks[0], read.Tasks[0].Children[0], read.Tasks[1]
+		if task.Name != "Renamed task" || task.Description != "v2 only" || len(task.Labels) != 1 || task.Labels[0] != "v2" {
+			t.Errorf("Expected the v1 update to keep the v2 fields, got %v", task)
+		}
+		if child.Status != schema_service_v2.TaskStatus_TASK_STATUS_DONE || len(child.Labels) != 1 || child.Labels[0] != "child" {
+			t.Errorf("Expected the child to keep its labels, got %v", child)
+		}
+		if added.Description != "" || len(added.Labels) != 0 {
+			t.Errorf("Expected the new task to have no v2 fields, got %v", added)
+		}
+	})
+
+	t.Run("V1ImportKeepsV2Fields", func(t *testing.T) {
+		v1, v2 := newV1AndV2Servers(t)
+		ctx := context.Background()
+
+		created, err := v2.CreateSchema(ctx, &schema_service_v2.CreateSchemaRequest{
+			AuthorId:   "authorID",
+			SchemaName: "OverwrittenByV1",
+			Tasks: []*schema_service_v2.Task{
+				{Id: 1, Level: 1, Name: "Task", Status: schema_service_v2.TaskStatus_TASK_STATUS_NOT_STARTED, Description: "v2 only", Labels: []string{"v2"}},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		stream := &MockImportSchemasServer{requests: []*schema_service.ImportSchemasRequest{
+			{Payload: &schema_service.ImportSchemasRequest_Options{Options: &schema_service.ImportOptions{
+				ConflictPolicy: schema_service.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_OVERWRITE,
+			}}},
+			{Payload: &schema_service.ImportSchemasRequest_Schema{Schema: &schema_service.ImportedSchema{
+				AuthorId:   "importer",
+				SchemaName: "OverwrittenByV1",
+				Tasks:      []*schema_service.Task{{Id: 1, Level: 1, Name: "Imported task", Status: schema_service.TaskStatus_TASK_STATUS_DONE}},
+			}}},
+		}}
+		if err := v1.ImportSchemas(stream); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if stream.response.Summary.Overwritten != 1 {
+			t.Fatalf("Expected the schema to be overwritten, got %+v", stream.response.Summary)
+		}
+
+		read, err := v2.GetSchema(ctx, &schema_service_v2.GetSchemaRequest{SchemaId: created.SchemaId})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		task := read.Tasks[0]
+		if task.Name != "Imported task" || task.Description != "v2 only" || len(task.Labels) != 1 || task.Labels[0] != "v2" {
+			t.Errorf("Expected the v1 import to keep the v2 fields, got %v", task)
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"server/internal/domain"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// ErrorDomain is reported in the ErrorInfo details of every translated error.
+const ErrorDomain = "schema_service.alt_team"
+
+var errorCodes = []struct {
+	kind error
+	code codes.Code
+}{
+	{domain.ErrNotFound, codes.NotFound},
+	{domain.ErrAlreadyExists, codes.AlreadyExists},
+	{domain.ErrInvalidArgument, codes.InvalidArgument},
+	{domain.ErrFailedPrecondition, codes.FailedPrecondition},
+	{domain.ErrPermissionDenied, codes.PermissionDenied},
+	{domain.ErrResourceExhausted, codes.ResourceExhausted},
+	{domain.ErrAborted, codes.Aborted},
+	{domain.ErrInternal, codes.Internal},
+	{context.Canceled, codes.Canceled},
+	{context.DeadlineExceeded, codes.DeadlineExceeded},
+}
+
+// StatusFromError translates err into a gRPC status. Domain errors get the
+// code matching their kind plus ErrorInfo and BadRequest details; errors that
+// already carry a status are returned as is.
+func StatusFromError(err error) *status.Status {
+	if err == nil {
+		return nil
+	}
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+
+	code := codes.Unknown
+	for _, e := range errorCodes {
+		if errors.Is(err, e.kind) {
+			code = e.code
+			break
+		}
+	}
+
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		return status.New(code, err.Error())
+	}
+
+	var details []protoadapt.MessageV1
+	if domainErr.Reason != "" {
+		details = append(details, &errdetails.ErrorInfo{
+			Reason:   domainErr.Reason,
+			Domain:   ErrorDomain,
+			Metadata: domainErr.Metadata,
+		})
+	}
+	if len(domainErr.Violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range domainErr.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		details = append(details, badRequest)
+	}
+
+	st := status.New(code, domainErr.Message)
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		st = withDetails
+	}
+	return st
+}
+
+// ErrorUnaryInterceptor converts errors returned by unary handlers into
+// gRPC statuses with StatusFromError.
+func ErrorUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, StatusFromError(err).Err()
+	}
+	return resp, nil
+}
+
+// ErrorStreamInterceptor converts errors returned by streaming handlers into
+// gRPC statuses with StatusFromError.
+func ErrorStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := handler(srv, ss); err != nil {
+		return StatusFromError(err).Err()
+	}
+	return nil
+}
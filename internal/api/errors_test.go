@@ -0,0 +1,97 @@
+package api_test
+
+import (
+	"context"
+	"fmt"
+	"server/internal/api"
+	"server/internal/domain"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusFromError(t *testing.T) {
+	t.Run("Domain kinds", func(t *testing.T) {
+		cases := []struct {
+			err  error
+			code codes.Code
+		}{
+			{domain.SchemaNotFoundError("id"), codes.NotFound},
+			{domain.SchemaNameTakenError("name"), codes.AlreadyExists},
+			{domain.InvalidArgumentError("REASON", nil, "bad"), codes.InvalidArgument},
+			{domain.NewError(domain.ErrFailedPrecondition, "REASON", nil, "not yet"), codes.FailedPrecondition},
+			{domain.NewError(domain.ErrPermissionDenied, "REASON", nil, "not you"), codes.PermissionDenied},
+			{domain.InternalError("REASON", "boom"), codes.Internal},
+			{fmt.Errorf("wrapped: %w", domain.SchemaNotFoundError("id")), codes.NotFound},
+			{context.DeadlineExceeded, codes.DeadlineExceeded},
+			{fmt.Errorf("unclassified"), codes.Unknown},
+		}
+
+		for _, c := range cases {
+			if code := api.StatusFromError(c.err).Code(); code != c.code {
+				t.Errorf("Expected %s for %v, got %s", c.code, c.err, code)
+			}
+		}
+	})
+
+	t.Run("ErrorInfo details", func(t *testing.T) {
+		st := api.StatusFromError(domain.SchemaNotFoundError("schemaID"))
+
+		if st.Message() != "schema with id=<schemaID> not found" {
+			t.Errorf("Unexpected message %q", st.Message())
+		}
+
+		if len(st.Details()) != 1 {
+			t.Fatalf("Expected 1 detail, got %d", len(st.Details()))
+		}
+
+		info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+		if !ok {
+			t.Fatalf("Expected ErrorInfo, got %T", st.Details()[0])
+		}
+
+		if info.Reason != "SCHEMA_NOT_FOUND" || info.Domain != api.ErrorDomain || info.Metadata["schema_id"] != "schemaID" {
+			t.Errorf("Unexpected ErrorInfo %+v", info)
+		}
+	})
+
+	t.Run("BadRequest details", func(t *testing.T) {
+		violations := []domain.FieldViolation{{Field: "query", Description: "must not be empty"}}
+		st := api.StatusFromError(domain.InvalidArgumentError("EMPTY_QUERY", violations, "bad query"))
+
+		var badRequest *errdetails.BadRequest
+		for _, detail := range st.Details() {
+			if br, ok := detail.(*errdetails.BadRequest); ok {
+				badRequest = br
+			}
+		}
+
+		if badRequest == nil || len(badRequest.FieldViolations) != 1 || badRequest.FieldViolations[0].Field != "query" {
+			t.Errorf("Unexpected BadRequest %+v", badRequest)
+		}
+	})
+
+	t.Run("Existing status is kept", func(t *testing.T) {
+		err := status.Error(codes.PermissionDenied, "nope")
+
+		if code := api.StatusFromError(err).Code(); code != codes.PermissionDenied {
+			t.Errorf("Expected PermissionDenied, got %s", code)
+		}
+	})
+}
+
+func TestErrorUnaryInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/alt_team.schema_service.SchemaService/GetSchemaByID"}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return nil, domain.SchemaNotFoundError("schemaID")
+	}
+
+	_, err := api.ErrorUnaryInterceptor(context.Background(), nil, info, handler)
+
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound, got %v", err)
+	}
+}
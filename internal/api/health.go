@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"log"
+	"time"
+
+	schema_service "server/proto"
+	schema_service_v2 "server/proto/v2"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const DefaultHealthInterval = 5 * time.Second
+
+// ReadinessProbe reports whether a dependency can serve requests.
+type ReadinessProbe interface {
+	Ready() error
+}
+
+// MonitorHealth keeps the health status of both versions of the schema
+// service, and of the server as a whole, in line with probe, checking it
+// every interval until ctx ends. The services are then reported as NOT_SERVING.
+func MonitorHealth(ctx context.Context, server *health.Server, probe ReadinessProbe, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last healthpb.HealthCheckResponse_ServingStatus
+	for {
+		status := healthpb.HealthCheckResponse_SERVING
+		if err := probe.Ready(); err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			if last != status {
+				log.Printf("storage is not ready: %v", err)
+			}
+		} else if last == healthpb.HealthCheckResponse_NOT_SERVING {
+			log.Printf("storage is ready again")
+		}
+		last = status
+
+		server.SetServingStatus("", status)
+		server.SetServingStatus(schema_service.SchemaService_ServiceDesc.ServiceName, status)
+		server.SetServingStatus(schema_service_v2.SchemaService_ServiceDesc.ServiceName, status)
+
+		select {
+		case <-ctx.Done():
+			server.Shutdown()
+			return
+		case <-ticker.C:
+		}
+	}
+}
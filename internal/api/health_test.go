@@ -0,0 +1,70 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"server/internal/api"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type MockProbe struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (mp *MockProbe) Ready() error {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	return mp.err
+}
+
+func (mp *MockProbe) set(err error) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	mp.err = err
+}
+
+func waitForStatus(t *testing.T, server *health.Server, service string, expected healthpb.HealthCheckResponse_ServingStatus) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for {
+		response, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err == nil && response.Status == expected {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected %s to be %v, got %v (%v)", service, expected, response.GetStatus(), err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestMonitorHealth(t *testing.T) {
+	server := health.NewServer()
+	probe := &MockProbe{}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		api.MonitorHealth(ctx, server, probe, time.Millisecond)
+		close(done)
+	}()
+
+	service := "alt_team.schema_service.SchemaService"
+	waitForStatus(t, server, service, healthpb.HealthCheckResponse_SERVING)
+	waitForStatus(t, server, "", healthpb.HealthCheckResponse_SERVING)
+
+	probe.set(errors.New("storage file is not writable"))
+	waitForStatus(t, server, service, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	probe.set(nil)
+	waitForStatus(t, server, service, healthpb.HealthCheckResponse_SERVING)
+
+	cancel()
+	<-done
+	waitForStatus(t, server, service, healthpb.HealthCheckResponse_NOT_SERVING)
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"server/internal/domain"
+	"server/internal/providers/idempotency"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// IdempotencyKeyHeader is the metadata key clients set to make retries
+	// of a mutation safe.
+	IdempotencyKeyHeader = "idempotency-key"
+	// IdempotentReplayHeader is set to "true" on responses replayed from an
+	// earlier call with the same key.
+	IdempotentReplayHeader = "idempotent-replay"
+
+	maxIdempotencyKeyLen = 256
+)
+
+// Mutations honouring idempotency keys. Other methods ignore the header.
+var idempotentMethods = map[string]bool{
+	"/alt_team.schema_service.SchemaService/CreateSchema":        true,
+	"/alt_team.schema_service.SchemaService/DeleteSchemaByID":    true,
+	"/alt_team.schema_service.SchemaService/BatchDeleteSchemas":  true,
+	"/alt_team.schema_service.SchemaService/CloneSchema":         true,
+	"/alt_team.schema_service.SchemaService/RenameSchema":        true,
+	"/alt_team.schema_service.SchemaService/UpdateSchema":        true,
+	"/alt_team.schema_service.SchemaService/PublishSchema":       true,
+	"/alt_team.schema_service.SchemaService/TransitionSchema":    true,
+	"/alt_team.schema_service.SchemaService/RequestSchemaReview": true,
+	"/alt_team.schema_service.SchemaService/ApproveSchema":       true,
+	"/alt_team.schema_service.SchemaService/RejectSchema":        true,
+	"/alt_team.schema_service.v2.SchemaService/CreateSchema":     true,
+	"/alt_team.schema_service.v2.SchemaService/DeleteSchema":     true,
+}
+
+// IdempotencyUnaryInterceptor makes mutations called with an idempotency key
+// return the original response when retried with the same request, and fail
+// with AlreadyExists when the key is reused for a different request.
+func IdempotencyUnaryInterceptor(store *idempotency.Store) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		msg, ok := req.(proto.Message)
+		if !idempotentMethods[info.FullMethod] || !ok {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		keys := md.Get(IdempotencyKeyHeader)
+		if len(keys) == 0 {
+			return handler(ctx, req)
+		}
+		key := keys[0]
+		if key == "" || len(key) > maxIdempotencyKeyLen {
+			return nil, domain.InvalidArgumentError("INVALID_IDEMPOTENCY_KEY", []domain.FieldViolation{
+				{Field: IdempotencyKeyHeader, Description: "must contain between 1 and 256 bytes"},
+			}, "idempotency key must contain between 1 and 256 bytes")
+		}
+
+		hash, err := requestHash(msg)
+		if err != nil {
+			return nil, domain.InternalError("REQUEST_HASH_FAILED", "failed to hash request: %v", err)
+		}
+
+		// Keys are scoped to the caller, so callers cannot replay each other's
+		// responses
+		scope := info.FullMethod + " " + strconv.Quote(domain.RequestInfoFrom(ctx).CallerID)
+		replayed := true
+		resp, err := store.Do(ctx, scope+" "+key, hash, func() (any, error) {
+			replayed = false
+			return handler(ctx, req)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if replayed {
+			grpc.SetHeader(ctx, metadata.Pairs(IdempotentReplayHeader, "true"))
+			// Callers must not share the stored message
+			if msg, ok := resp.(proto.Message); ok {
+				return proto.Clone(msg), nil
+			}
+		}
+		return resp, nil
+	}
+}
+
+func requestHash(msg proto.Message) ([]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	return sum[:], nil
+}
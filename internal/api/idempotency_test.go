@@ -0,0 +1,114 @@
+package api_test
+
+import (
+	"context"
+	"server/internal/api"
+	"server/internal/domain"
+	"server/internal/providers/idempotency"
+	schema_service "server/proto"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestIdempotencyUnaryInterceptor(t *testing.T) {
+	interceptor := api.IdempotencyUnaryInterceptor(idempotency.NewStore(time.Hour))
+	createInfo := &grpc.UnaryServerInfo{FullMethod: "/alt_team.schema_service.SchemaService/CreateSchema"}
+
+	calls := 0
+	handler := func(ctx context.Context, req any) (any, error) {
+		calls++
+		request := req.(*schema_service.CreateSchemaRequest)
+		return &schema_service.CreateSchemaResponse{Schema: &schema_service.Schema{SchemaName: request.SchemaName}}, nil
+	}
+	withKey := func(key string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(api.IdempotencyKeyHeader, key))
+	}
+	request := &schema_service.CreateSchemaRequest{AuthorId: "author", SchemaName: "schema"}
+
+	t.Run("WithoutKey", func(t *testing.T) {
+		calls = 0
+		interceptor(context.Background(), request, createInfo, handler)
+		interceptor(context.Background(), request, createInfo, handler)
+
+		if calls != 2 {
+			t.Errorf("Expected every call to run, got %d calls", calls)
+		}
+	})
+
+	t.Run("ReplaysRetry", func(t *testing.T) {
+		calls = 0
+		first, err := interceptor(withKey("retry"), request, createInfo, handler)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		second, err := interceptor(withKey("retry"), proto.Clone(request), createInfo, handler)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if calls != 1 || !proto.Equal(first.(proto.Message), second.(proto.Message)) {
+			t.Errorf("Expected the original response once, got %d calls", calls)
+		}
+	})
+
+	t.Run("KeyReusedForAnotherRequest", func(t *testing.T) {
+		interceptor(withKey("reused"), request, createInfo, handler)
+		other := &schema_service.CreateSchemaRequest{AuthorId: "author", SchemaName: "other"}
+		_, err := interceptor(withKey("reused"), other, createInfo, handler)
+
+		if api.StatusFromError(err).Code() != codes.AlreadyExists {
+			t.Errorf("Expected AlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("KeysAreScopedByMethod", func(t *testing.T) {
+		calls = 0
+		cloneInfo := &grpc.UnaryServerInfo{FullMethod: "/alt_team.schema_service.SchemaService/CloneSchema"}
+		interceptor(withKey("scoped"), request, createInfo, handler)
+		interceptor(withKey("scoped"), request, cloneInfo, handler)
+
+		if calls != 2 {
+			t.Errorf("Expected both methods to run, got %d calls", calls)
+		}
+	})
+
+	t.Run("KeysAreScopedByCaller", func(t *testing.T) {
+		calls = 0
+		for _, caller := range []string{"alice", "bob"} {
+			ctx := domain.WithRequestInfo(withKey("shared"), domain.RequestInfo{CallerID: caller})
+			interceptor(ctx, request, createInfo, handler)
+		}
+
+		if calls != 2 {
+			t.Errorf("Expected both callers to run, got %d calls", calls)
+		}
+	})
+
+	t.Run("ReadsAreNotDeduplicated", func(t *testing.T) {
+		calls = 0
+		readInfo := &grpc.UnaryServerInfo{FullMethod: "/alt_team.schema_service.SchemaService/GetAllSchemas"}
+		interceptor(withKey("read"), request, readInfo, handler)
+		interceptor(withKey("read"), request, readInfo, handler)
+
+		if calls != 2 {
+			t.Errorf("Expected every call to run, got %d calls", calls)
+		}
+	})
+
+	t.Run("KeyTooLong", func(t *testing.T) {
+		key := make([]byte, 257)
+		for i := range key {
+			key[i] = 'k'
+		}
+		_, err := interceptor(withKey(string(key)), request, createInfo, handler)
+
+		if api.StatusFromError(err).Code() != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"server/internal/domain"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// RequestIDHeader carries the id of a call. Ids sent by clients are kept
+	// when valid, otherwise one is generated; it is returned in the response
+	// header either way.
+	RequestIDHeader = "x-request-id"
+	// CallerIDHeader identifies the caller. It is only read from trusted
+	// callers, i.e. the authenticating proxy in front of the service, and
+	// ignored otherwise.
+	CallerIDHeader = "x-caller-id"
+	// CallerRolesHeader lists the roles of the caller, comma-separated or
+	// repeated, and is read the same way.
+	CallerRolesHeader = "x-caller-roles"
+
+	maxRequestIDLen = 128
+)
+
+// RequestInfoUnaryInterceptor attaches the domain.RequestInfo of the call to
+// its context. Without trustCallers every caller is anonymous.
+func RequestInfoUnaryInterceptor(trustCallers bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		requestInfo := requestInfoOf(ctx, trustCallers)
+		grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestInfo.RequestID))
+		return handler(domain.WithRequestInfo(ctx, requestInfo), req)
+	}
+}
+
+// RequestInfoStreamInterceptor attaches the domain.RequestInfo of the call
+// to the context of the stream.
+func RequestInfoStreamInterceptor(trustCallers bool) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		requestInfo := requestInfoOf(ss.Context(), trustCallers)
+		ss.SetHeader(metadata.Pairs(RequestIDHeader, requestInfo.RequestID))
+		return handler(srv, &contextStream{ServerStream: ss, ctx: domain.WithRequestInfo(ss.Context(), requestInfo)})
+	}
+}
+
+func requestInfoOf(ctx context.Context, trustCallers bool) domain.RequestInfo {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var requestInfo domain.RequestInfo
+	if ids := md.Get(RequestIDHeader); len(ids) > 0 && validRequestID(ids[0]) {
+		requestInfo.RequestID = ids[0]
+	} else {
+		requestInfo.RequestID = uuid.New().String()
+	}
+	if !trustCallers {
+		return requestInfo
+	}
+	if callers := md.Get(CallerIDHeader); len(callers) > 0 {
+		requestInfo.CallerID = callers[0]
+	}
+	for _, roles := range md.Get(CallerRolesHeader) {
+		for _, role := range strings.Split(roles, ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				requestInfo.CallerRoles = append(requestInfo.CallerRoles, role)
+			}
+		}
+	}
+	return requestInfo
+}
+
+// validRequestID accepts printable ASCII ids, so they can be logged safely.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,91 @@
+package api_test
+
+import (
+	"context"
+	"reflect"
+	"server/internal/api"
+	"server/internal/domain"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type MockServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (mss *MockServerStream) Context() context.Context { return mss.ctx }
+
+func (mss *MockServerStream) SetHeader(md metadata.MD) error {
+	mss.header = metadata.Join(mss.header, md)
+	return nil
+}
+
+func TestRequestInfoUnaryInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/alt_team.schema_service.SchemaService/GetAllSchemas"}
+	var seen domain.RequestInfo
+	handler := func(ctx context.Context, req any) (any, error) {
+		seen = domain.RequestInfoFrom(ctx)
+		return nil, nil
+	}
+
+	t.Run("KeepsClientIDs", func(t *testing.T) {
+		md := metadata.Pairs(api.RequestIDHeader, "req-42", api.CallerIDHeader, "alice")
+		api.RequestInfoUnaryInterceptor(true)(metadata.NewIncomingContext(context.Background(), md), nil, info, handler)
+
+		if seen.RequestID != "req-42" || seen.CallerID != "alice" || seen.CallerRoles != nil {
+			t.Errorf("Unexpected request info %+v", seen)
+		}
+	})
+
+	t.Run("SplitsRoles", func(t *testing.T) {
+		md := metadata.Pairs(api.CallerRolesHeader, "medical_lead, pharmacist", api.CallerRolesHeader, "nurse,")
+		api.RequestInfoUnaryInterceptor(true)(metadata.NewIncomingContext(context.Background(), md), nil, info, handler)
+
+		if !reflect.DeepEqual(seen.CallerRoles, []string{"medical_lead", "pharmacist", "nurse"}) {
+			t.Errorf("Unexpected roles %v", seen.CallerRoles)
+		}
+	})
+
+	t.Run("IgnoresUntrustedCallers", func(t *testing.T) {
+		md := metadata.Pairs(api.RequestIDHeader, "req-42", api.CallerIDHeader, "mallory", api.CallerRolesHeader, "pharmacist")
+		api.RequestInfoUnaryInterceptor(false)(metadata.NewIncomingContext(context.Background(), md), nil, info, handler)
+
+		if seen.RequestID != "req-42" || seen.CallerID != "" || seen.CallerRoles != nil {
+			t.Errorf("Expected an anonymous caller, got %+v", seen)
+		}
+	})
+
+	t.Run("GeneratesMissingOrInvalidID", func(t *testing.T) {
+		for _, md := range []metadata.MD{{}, metadata.Pairs(api.RequestIDHeader, "has space"), metadata.Pairs(api.RequestIDHeader, strings.Repeat("x", 129))} {
+			api.RequestInfoUnaryInterceptor(true)(metadata.NewIncomingContext(context.Background(), md), nil, info, handler)
+
+			if len(seen.RequestID) != 36 || seen.CallerID != "" {
+				t.Errorf("Expected a generated id for %v, got %+v", md, seen)
+			}
+		}
+	})
+}
+
+func TestRequestInfoStreamInterceptor(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/alt_team.schema_service.SchemaService/WatchSchemas"}
+	md := metadata.Pairs(api.RequestIDHeader, "req-7", api.CallerIDHeader, "bob")
+	stream := &MockServerStream{ctx: metadata.NewIncomingContext(context.Background(), md)}
+
+	var seen domain.RequestInfo
+	api.RequestInfoStreamInterceptor(true)(nil, stream, info, func(srv any, ss grpc.ServerStream) error {
+		seen = domain.RequestInfoFrom(ss.Context())
+		return nil
+	})
+
+	if seen.RequestID != "req-7" || seen.CallerID != "bob" {
+		t.Errorf("Unexpected request info %+v", seen)
+	}
+	if ids := stream.header.Get(api.RequestIDHeader); len(ids) != 1 || ids[0] != "req-7" {
+		t.Errorf("Expected the request id to be returned, got %v", stream.header)
+	}
+}
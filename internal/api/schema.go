@@ -2,17 +2,42 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"server/internal/domain"
 
 	schema_service "server/proto"
 )
 
 type SchemaHandler interface {
-	Create(authorID string, schemaName string, tasks []domain.Task) (domain.Schema, error)
-	GetAll() ([]domain.Schema, error)
-	GetByID(id string) (domain.Schema, error)
-	DeleteByID(id string) error
+	Create(ctx context.Context, authorID string, schemaName string, tasks []domain.Task, assignTaskIDs bool) (domain.Schema, error)
+	GetAll(ctx context.Context, states []domain.SchemaState) ([]domain.Schema, error)
+	GetByID(ctx context.Context, id string) (domain.Schema, error)
+	DeleteByID(ctx context.Context, id string) error
+	Search(ctx context.Context, query string, limit int) ([]domain.SearchResult, error)
+	GetByIDs(ctx context.Context, ids []string) ([]domain.SchemaResult, error)
+	DeleteByIDs(ctx context.Context, ids []string) ([]domain.SchemaResult, error)
+	Watch(ctx context.Context, fromRevision int64, filter domain.EventFilter) (domain.EventStream, error)
+	Export(ctx context.Context, filter domain.ExportFilter) (domain.Snapshot, error)
+	ChangesSince(ctx context.Context, revision int64, limit int) (domain.ChangeSet, error)
+	Clone(ctx context.Context, sourceID string, authorID string, schemaName string, renumberTasks bool) (domain.Schema, error)
+	ListDerived(ctx context.Context, id string, transitive bool) ([]domain.Schema, error)
+	Validate(ctx context.Context, authorID string, schemaName string, tasks []domain.Task) ([]domain.Diagnostic, error)
+	Import(ctx context.Context, items []domain.ImportItem, policy domain.ConflictPolicy) ([]domain.ImportResult, error)
+	GetByName(ctx context.Context, name string) (domain.Schema, error)
+	ListByAuthor(ctx context.Context, authorID string, states []domain.SchemaState) ([]domain.Schema, error)
+	GetBySlug(ctx context.Context, slug string) (domain.Schema, bool, error)
+	Rename(ctx context.Context, id string, name string) (domain.Schema, error)
+	Update(ctx context.Context, id string, tasks []domain.Task, assignTaskIDs bool) (domain.Schema, error)
+	Publish(ctx context.Context, id string) (domain.SchemaVersion, error)
+	ListVersions(ctx context.Context, id string) ([]domain.SchemaVersion, error)
+	GetVersion(ctx context.Context, id string, version int64) (domain.SchemaVersion, error)
+	Transition(ctx context.Context, id string, state domain.SchemaState, comment string) (domain.Schema, error)
+	RequestReview(ctx context.Context, id string, comment string) (domain.Schema, error)
+	Approve(ctx context.Context, id string, comment string) (domain.Schema, error)
+	Reject(ctx context.Context, id string, comment string) (domain.Schema, error)
+	Diff(ctx context.Context, fromID string, fromVersion int64, toID string, toVersion int64) (domain.SchemaDiff, error)
 }
 
 type SchemaServer struct {
@@ -27,7 +52,7 @@ func (s *SchemaServer) CreateSchema(ctx context.Context, req *schema_service.Cre
 	var tasks []domain.Task = domain.TasksFromGRPC(req.Tasks)
 
 	// Invoke SchemaHandler for creation
-	schema, err := s.SchemaHandler.Create(req.AuthorId, req.SchemaName, tasks)
+	schema, err := s.SchemaHandler.Create(ctx, req.AuthorId, req.SchemaName, tasks, req.AssignTaskIds)
 	if err != nil {
 		fmt.Println("Error calling SchemaHandler.Create: ", err)
 		return nil, err
@@ -46,7 +71,7 @@ func (s *SchemaServer) GetAllSchemas(ctx context.Context, req *schema_service.Ge
 	fmt.Println("START GetAllSchemas API")
 
 	// Invoke SchemaHandler for fetching the schema
-	schemas, err := s.SchemaHandler.GetAll()
+	schemas, err := s.SchemaHandler.GetAll(ctx, domain.SchemaStatesFromGRPC(req.States))
 	if err != nil {
 		fmt.Println("Error calling SchemaHandler.GetAll: ", err)
 		return nil, err
@@ -71,7 +96,7 @@ func (s *SchemaServer) GetSchemaByID(ctx context.Context, req *schema_service.Ge
 	fmt.Println("START GetSchemaByID API")
 
 	// Invoke SchemaHandler for fetching the schema
-	schema, err := s.SchemaHandler.GetByID(req.SchemaId)
+	schema, err := s.SchemaHandler.GetByID(ctx, req.SchemaId)
 	if err != nil {
 		fmt.Println("Error calling SchemaHandler.GetByID: ", err)
 		return nil, err
@@ -87,11 +112,268 @@ func (s *SchemaServer) GetSchemaByID(ctx context.Context, req *schema_service.Ge
 	return response, nil
 }
 
+func (s *SchemaServer) GetSchemaByName(ctx context.Context, req *schema_service.GetSchemaByNameRequest) (*schema_service.GetSchemaByNameResponse, error) {
+	fmt.Println("START GetSchemaByName API")
+
+	// Invoke SchemaHandler for fetching the schema
+	schema, err := s.SchemaHandler.GetByName(ctx, req.SchemaName)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.GetByName: ", err)
+		return nil, err
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.GetSchemaByNameResponse{
+		Schema: domain.SchemaToGRPC(&schema),
+	}
+
+	fmt.Println("END GetSchemaByName API")
+	return response, nil
+}
+
+func (s *SchemaServer) ListSchemasByAuthor(ctx context.Context, req *schema_service.ListSchemasByAuthorRequest) (*schema_service.ListSchemasByAuthorResponse, error) {
+	fmt.Println("START ListSchemasByAuthor API")
+
+	// Invoke SchemaHandler for fetching the schemas
+	schemas, err := s.SchemaHandler.ListByAuthor(ctx, req.AuthorId, domain.SchemaStatesFromGRPC(req.States))
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.ListByAuthor: ", err)
+		return nil, err
+	}
+
+	// Convert to gRPC objects
+	var grpcSchemas []*schema_service.Schema
+	for _, schema := range schemas {
+		grpcSchemas = append(grpcSchemas, domain.SchemaToGRPC(&schema))
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.ListSchemasByAuthorResponse{
+		Schemas: grpcSchemas,
+	}
+
+	fmt.Println("END ListSchemasByAuthor API")
+	return response, nil
+}
+
+func (s *SchemaServer) GetSchemaBySlug(ctx context.Context, req *schema_service.GetSchemaBySlugRequest) (*schema_service.GetSchemaBySlugResponse, error) {
+	fmt.Println("START GetSchemaBySlug API")
+
+	// Invoke SchemaHandler for resolving the slug
+	schema, alias, err := s.SchemaHandler.GetBySlug(ctx, req.Slug)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.GetBySlug: ", err)
+		return nil, err
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.GetSchemaBySlugResponse{
+		Schema: domain.SchemaToGRPC(&schema),
+		Alias:  alias,
+	}
+
+	fmt.Println("END GetSchemaBySlug API")
+	return response, nil
+}
+
+func (s *SchemaServer) RenameSchema(ctx context.Context, req *schema_service.RenameSchemaRequest) (*schema_service.RenameSchemaResponse, error) {
+	fmt.Println("START RenameSchema API")
+
+	// Invoke SchemaHandler for renaming the schema
+	schema, err := s.SchemaHandler.Rename(ctx, req.SchemaId, req.SchemaName)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.Rename: ", err)
+		return nil, err
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.RenameSchemaResponse{
+		Schema: domain.SchemaToGRPC(&schema),
+	}
+
+	fmt.Println("END RenameSchema API")
+	return response, nil
+}
+
+func (s *SchemaServer) UpdateSchema(ctx context.Context, req *schema_service.UpdateSchemaRequest) (*schema_service.UpdateSchemaResponse, error) {
+	fmt.Println("START UpdateSchema API")
+
+	// Invoke SchemaHandler for updating the draft
+	schema, err := s.SchemaHandler.Update(ctx, req.SchemaId, domain.TasksFromGRPC(req.Tasks), req.AssignTaskIds)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.Update: ", err)
+		return nil, err
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.UpdateSchemaResponse{
+		Schema: domain.SchemaToGRPC(&schema),
+	}
+
+	fmt.Println("END UpdateSchema API")
+	return response, nil
+}
+
+func (s *SchemaServer) PublishSchema(ctx context.Context, req *schema_service.PublishSchemaRequest) (*schema_service.PublishSchemaResponse, error) {
+	fmt.Println("START PublishSchema API")
+
+	// Invoke SchemaHandler for publishing the draft
+	version, err := s.SchemaHandler.Publish(ctx, req.SchemaId)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.Publish: ", err)
+		return nil, err
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.PublishSchemaResponse{
+		Version: domain.SchemaVersionToGRPC(req.SchemaId, &version),
+	}
+
+	fmt.Println("END PublishSchema API")
+	return response, nil
+}
+
+func (s *SchemaServer) ListSchemaVersions(ctx context.Context, req *schema_service.ListSchemaVersionsRequest) (*schema_service.ListSchemaVersionsResponse, error) {
+	fmt.Println("START ListSchemaVersions API")
+
+	// Invoke SchemaHandler for listing the published versions
+	versions, err := s.SchemaHandler.ListVersions(ctx, req.SchemaId)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.ListVersions: ", err)
+		return nil, err
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.ListSchemaVersionsResponse{}
+	for _, version := range versions {
+		response.Versions = append(response.Versions, domain.SchemaVersionToGRPC(req.SchemaId, &version))
+	}
+
+	fmt.Println("END ListSchemaVersions API")
+	return response, nil
+}
+
+func (s *SchemaServer) GetSchemaVersion(ctx context.Context, req *schema_service.GetSchemaVersionRequest) (*schema_service.GetSchemaVersionResponse, error) {
+	fmt.Println("START GetSchemaVersion API")
+
+	// Invoke SchemaHandler for getting the published version
+	version, err := s.SchemaHandler.GetVersion(ctx, req.SchemaId, req.Version)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.GetVersion: ", err)
+		return nil, err
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.GetSchemaVersionResponse{
+		Version: domain.SchemaVersionToGRPC(req.SchemaId, &version),
+	}
+
+	fmt.Println("END GetSchemaVersion API")
+	return response, nil
+}
+
+func (s *SchemaServer) TransitionSchema(ctx context.Context, req *schema_service.TransitionSchemaRequest) (*schema_service.TransitionSchemaResponse, error) {
+	fmt.Println("START TransitionSchema API")
+
+	// Invoke SchemaHandler for moving the schema to the new state
+	schema, err := s.SchemaHandler.Transition(ctx, req.SchemaId, domain.SchemaStateFromGRPC(req.State), req.Comment)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.Transition: ", err)
+		return nil, err
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.TransitionSchemaResponse{
+		Schema: domain.SchemaToGRPC(&schema),
+	}
+
+	fmt.Println("END TransitionSchema API")
+	return response, nil
+}
+
+func (s *SchemaServer) RequestSchemaReview(ctx context.Context, req *schema_service.RequestSchemaReviewRequest) (*schema_service.RequestSchemaReviewResponse, error) {
+	fmt.Println("START RequestSchemaReview API")
+
+	// Invoke SchemaHandler for sending the schema to review
+	schema, err := s.SchemaHandler.RequestReview(ctx, req.SchemaId, req.Comment)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.RequestReview: ", err)
+		return nil, err
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.RequestSchemaReviewResponse{
+		Schema: domain.SchemaToGRPC(&schema),
+	}
+
+	fmt.Println("END RequestSchemaReview API")
+	return response, nil
+}
+
+func (s *SchemaServer) ApproveSchema(ctx context.Context, req *schema_service.ApproveSchemaRequest) (*schema_service.ApproveSchemaResponse, error) {
+	fmt.Println("START ApproveSchema API")
+
+	// Invoke SchemaHandler for approving the schema on behalf of the caller
+	schema, err := s.SchemaHandler.Approve(ctx, req.SchemaId, req.Comment)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.Approve: ", err)
+		return nil, err
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.ApproveSchemaResponse{
+		Schema: domain.SchemaToGRPC(&schema),
+	}
+
+	fmt.Println("END ApproveSchema API")
+	return response, nil
+}
+
+func (s *SchemaServer) RejectSchema(ctx context.Context, req *schema_service.RejectSchemaRequest) (*schema_service.RejectSchemaResponse, error) {
+	fmt.Println("START RejectSchema API")
+
+	// Invoke SchemaHandler for rejecting the schema on behalf of the caller
+	schema, err := s.SchemaHandler.Reject(ctx, req.SchemaId, req.Comment)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.Reject: ", err)
+		return nil, err
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.RejectSchemaResponse{
+		Schema: domain.SchemaToGRPC(&schema),
+	}
+
+	fmt.Println("END RejectSchema API")
+	return response, nil
+}
+
+func (s *SchemaServer) DiffSchemas(ctx context.Context, req *schema_service.DiffSchemasRequest) (*schema_service.DiffSchemasResponse, error) {
+	fmt.Println("START DiffSchemas API")
+
+	// Invoke SchemaHandler for comparing the schemas
+	diff, err := s.SchemaHandler.Diff(ctx, req.FromSchemaId, req.FromVersion, req.ToSchemaId, req.ToVersion)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.Diff: ", err)
+		return nil, err
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.DiffSchemasResponse{
+		SchemaChanges: domain.FieldChangesToGRPC(diff.SchemaChanges),
+		TaskChanges:   domain.TaskChangesToGRPC(diff.TaskChanges),
+		Text:          diff.Text(),
+	}
+
+	fmt.Println("END DiffSchemas API")
+	return response, nil
+}
+
 func (s *SchemaServer) DeleteSchemaByID(ctx context.Context, req *schema_service.DeleteSchemaByIDRequest) (*schema_service.DeleteSchemaByIDResponse, error) {
 	fmt.Println("START DeleteSchemaByID API")
 
 	// Invoke SchemaHandler for deleting the schema
-	err := s.SchemaHandler.DeleteByID(req.SchemaId)
+	err := s.SchemaHandler.DeleteByID(ctx, req.SchemaId)
 	if err != nil {
 		fmt.Println("Error calling SchemaHandler.DeleteByID: ", err)
 		return nil, err
@@ -105,3 +387,382 @@ func (s *SchemaServer) DeleteSchemaByID(ctx context.Context, req *schema_service
 	fmt.Println("END DeleteSchemaByID API")
 	return response, nil
 }
+
+func (s *SchemaServer) SearchSchemas(ctx context.Context, req *schema_service.SearchSchemasRequest) (*schema_service.SearchSchemasResponse, error) {
+	fmt.Println("START SearchSchemas API")
+
+	// Invoke SchemaHandler for searching the schemas
+	results, err := s.SchemaHandler.Search(ctx, req.Query, int(req.Limit))
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.Search: ", err)
+		return nil, err
+	}
+
+	// Convert to gRPC objects
+	var grpcResults []*schema_service.SearchResult
+	for _, result := range results {
+		grpcResults = append(grpcResults, domain.SearchResultToGRPC(&result))
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.SearchSchemasResponse{
+		Results: grpcResults,
+	}
+
+	fmt.Println("END SearchSchemas API")
+	return response, nil
+}
+
+func (s *SchemaServer) BatchGetSchemas(ctx context.Context, req *schema_service.BatchGetSchemasRequest) (*schema_service.BatchGetSchemasResponse, error) {
+	fmt.Println("START BatchGetSchemas API")
+
+	// Invoke SchemaHandler for fetching the schemas
+	results, err := s.SchemaHandler.GetByIDs(ctx, req.SchemaIds)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.GetByIDs: ", err)
+		return nil, err
+	}
+
+	// Convert to gRPC objects, keeping per-item errors
+	var grpcResults []*schema_service.BatchGetSchemaResult
+	for _, result := range results {
+		grpcResult := &schema_service.BatchGetSchemaResult{SchemaId: result.SchemaID}
+		if result.Err != nil {
+			grpcResult.Result = &schema_service.BatchGetSchemaResult_Error{Error: StatusFromError(result.Err).Proto()}
+		} else {
+			grpcResult.Result = &schema_service.BatchGetSchemaResult_Schema{Schema: domain.SchemaToGRPC(&result.Schema)}
+		}
+		grpcResults = append(grpcResults, grpcResult)
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.BatchGetSchemasResponse{
+		Results: grpcResults,
+	}
+
+	fmt.Println("END BatchGetSchemas API")
+	return response, nil
+}
+
+func (s *SchemaServer) BatchDeleteSchemas(ctx context.Context, req *schema_service.BatchDeleteSchemasRequest) (*schema_service.BatchDeleteSchemasResponse, error) {
+	fmt.Println("START BatchDeleteSchemas API")
+
+	// Invoke SchemaHandler for deleting the schemas
+	results, err := s.SchemaHandler.DeleteByIDs(ctx, req.SchemaIds)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.DeleteByIDs: ", err)
+		return nil, err
+	}
+
+	// Convert to gRPC objects, keeping per-item errors
+	var grpcResults []*schema_service.BatchDeleteSchemaResult
+	for _, result := range results {
+		grpcResult := &schema_service.BatchDeleteSchemaResult{SchemaId: result.SchemaID}
+		if result.Err != nil {
+			grpcResult.Error = StatusFromError(result.Err).Proto()
+		}
+		grpcResults = append(grpcResults, grpcResult)
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.BatchDeleteSchemasResponse{
+		Results: grpcResults,
+	}
+
+	fmt.Println("END BatchDeleteSchemas API")
+	return response, nil
+}
+
+func (s *SchemaServer) WatchSchemas(req *schema_service.WatchSchemasRequest, stream schema_service.SchemaService_WatchSchemasServer) error {
+	fmt.Println("START WatchSchemas API")
+
+	// Invoke SchemaHandler for subscribing to changes
+	filter := domain.EventFilter{AuthorID: req.AuthorId, SchemaIDs: req.SchemaIds}
+	events, err := s.SchemaHandler.Watch(stream.Context(), req.FromRevision, filter)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.Watch: ", err)
+		return err
+	}
+	defer events.Close()
+
+	// Forward events until the client goes away or the feed ends
+	for {
+		select {
+		case <-stream.Context().Done():
+			fmt.Println("END WatchSchemas API")
+			return nil
+		case event, ok := <-events.Events():
+			if !ok {
+				fmt.Println("Watch ended: ", events.Err())
+				return events.Err()
+			}
+			response := &schema_service.WatchSchemasResponse{
+				Event: domain.SchemaEventToGRPC(&event),
+			}
+			if err := stream.Send(response); err != nil {
+				fmt.Println("Error sending WatchSchemas event: ", err)
+				return err
+			}
+		}
+	}
+}
+
+func (s *SchemaServer) ExportSchemas(req *schema_service.ExportSchemasRequest, stream schema_service.SchemaService_ExportSchemasServer) error {
+	fmt.Println("START ExportSchemas API")
+
+	// Invoke SchemaHandler for taking the snapshot
+	filter := domain.ExportFilter{
+		AuthorIDs:  req.AuthorIds,
+		SchemaIDs:  req.SchemaIds,
+		NamePrefix: req.SchemaNamePrefix,
+		States:     domain.SchemaStatesFromGRPC(req.States),
+	}
+	if req.UpdatedSince != nil {
+		filter.UpdatedSince = req.UpdatedSince.AsTime()
+	}
+	snapshot, err := s.SchemaHandler.Export(stream.Context(), filter)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.Export: ", err)
+		return err
+	}
+
+	// Announce the snapshot, then send the schemas one message at a time
+	header := &schema_service.ExportSchemasResponse{
+		SnapshotRevision: snapshot.Revision,
+		Total:            int32(len(snapshot.Schemas)),
+	}
+	if err := stream.Send(header); err != nil {
+		fmt.Println("Error sending ExportSchemas snapshot: ", err)
+		return err
+	}
+	for i := range snapshot.Schemas {
+		response := &schema_service.ExportSchemasResponse{
+			Schema: domain.SchemaToGRPC(&snapshot.Schemas[i]),
+		}
+		if err := stream.Send(response); err != nil {
+			fmt.Println("Error sending ExportSchemas schema: ", err)
+			return err
+		}
+	}
+
+	fmt.Println("END ExportSchemas API")
+	return nil
+}
+
+func (s *SchemaServer) GetChangesSince(ctx context.Context, req *schema_service.GetChangesSinceRequest) (*schema_service.GetChangesSinceResponse, error) {
+	fmt.Println("START GetChangesSince API")
+
+	// Invoke SchemaHandler for collecting the changes
+	changes, err := s.SchemaHandler.ChangesSince(ctx, req.Revision, int(req.Limit))
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.ChangesSince: ", err)
+		return nil, err
+	}
+
+	// Convert and return gRPC response object
+	response := domain.ChangeSetToGRPC(&changes)
+
+	fmt.Println("END GetChangesSince API")
+	return response, nil
+}
+
+func (s *SchemaServer) CloneSchema(ctx context.Context, req *schema_service.CloneSchemaRequest) (*schema_service.CloneSchemaResponse, error) {
+	fmt.Println("START CloneSchema API")
+
+	// Invoke SchemaHandler for cloning
+	schema, err := s.SchemaHandler.Clone(ctx, req.SourceSchemaId, req.AuthorId, req.SchemaName, req.RenumberTaskIds)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.Clone: ", err)
+		return nil, err
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.CloneSchemaResponse{
+		Schema: domain.SchemaToGRPC(&schema),
+	}
+
+	fmt.Println("END CloneSchema API")
+	return response, nil
+}
+
+func (s *SchemaServer) ListDerivedSchemas(ctx context.Context, req *schema_service.ListDerivedSchemasRequest) (*schema_service.ListDerivedSchemasResponse, error) {
+	fmt.Println("START ListDerivedSchemas API")
+
+	// Invoke SchemaHandler for fetching the derivatives
+	schemas, err := s.SchemaHandler.ListDerived(ctx, req.SchemaId, req.Transitive)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.ListDerived: ", err)
+		return nil, err
+	}
+
+	// Convert to gRPC objects
+	var grpcSchemas []*schema_service.Schema
+	for _, schema := range schemas {
+		grpcSchemas = append(grpcSchemas, domain.SchemaToGRPC(&schema))
+	}
+
+	// Create and return gRPC response object
+	response := &schema_service.ListDerivedSchemasResponse{
+		Schemas: grpcSchemas,
+	}
+
+	fmt.Println("END ListDerivedSchemas API")
+	return response, nil
+}
+
+func (s *SchemaServer) ValidateSchema(ctx context.Context, req *schema_service.ValidateSchemaRequest) (*schema_service.ValidateSchemaResponse, error) {
+	fmt.Println("START ValidateSchema API")
+
+	// Report the field rules the interceptor let through for this method
+	diagnostics := ruleDiagnostics(Validate(req), req.Tasks)
+
+	// Invoke SchemaHandler for the structural and semantic checks
+	var tasks []domain.Task = domain.TasksFromGRPC(req.Tasks)
+	schemaDiagnostics, err := s.SchemaHandler.Validate(ctx, req.AuthorId, req.SchemaName, tasks)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.Validate: ", err)
+		return nil, err
+	}
+	diagnostics = append(diagnostics, schemaDiagnostics...)
+
+	// Create and return gRPC response object
+	response := &schema_service.ValidateSchemaResponse{
+		Valid:       !domain.HasErrors(diagnostics),
+		Diagnostics: domain.DiagnosticsToGRPC(diagnostics),
+	}
+
+	fmt.Println("END ValidateSchema API")
+	return response, nil
+}
+
+const defaultImportBatchSize = 100
+
+func (s *SchemaServer) ImportSchemas(stream schema_service.SchemaService_ImportSchemasServer) error {
+	fmt.Println("START ImportSchemas API")
+
+	ctx := stream.Context()
+	policy := domain.ConflictFail
+	batchSize := defaultImportBatchSize
+	summary := &schema_service.ImportSummary{}
+	var results []*schema_service.ImportItemResult
+	var batch []domain.ImportItem
+
+	record := func(result *schema_service.ImportItemResult, outcome domain.ImportOutcome) {
+		results[result.Index] = result
+		switch outcome {
+		case domain.ImportCreated:
+			summary.Created++
+		case domain.ImportRenamed:
+			summary.Renamed++
+		case domain.ImportOverwritten:
+			summary.Overwritten++
+		case domain.ImportSkipped:
+			summary.Skipped++
+		case domain.ImportInvalid:
+			summary.Invalid++
+		case domain.ImportFailed:
+			summary.Failed++
+		}
+	}
+
+	// Invoke SchemaHandler for committing the pending batch
+	commit := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		importResults, err := s.SchemaHandler.Import(ctx, batch, policy)
+		batch = nil
+		if errors.Is(err, domain.ErrAlreadyExists) && importResults != nil {
+			summary.Aborted = true
+		} else if err != nil {
+			fmt.Println("Error calling SchemaHandler.Import: ", err)
+			return err
+		}
+		for _, result := range importResults {
+			grpcResult := domain.ImportResultToGRPC(&result)
+			if result.Err != nil {
+				grpcResult.Error = StatusFromError(result.Err).Proto()
+			}
+			record(grpcResult, result.Outcome)
+		}
+		return nil
+	}
+
+	// Read the stream, committing every batchSize schemas
+	for !summary.Aborted {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fmt.Println("Error receiving ImportSchemas message: ", err)
+			return err
+		}
+
+		switch payload := req.Payload.(type) {
+		case *schema_service.ImportSchemasRequest_Options:
+			if len(results) > 0 {
+				return domain.InvalidArgumentError("OPTIONS_AFTER_SCHEMAS", nil, "import options must be sent before the schemas")
+			}
+			if violations := Validate(payload.Options); len(violations) > 0 {
+				return validationError(violations)
+			}
+			policy = domain.ConflictPolicyFromGRPC(payload.Options.ConflictPolicy)
+			if payload.Options.BatchSize > 0 {
+				batchSize = int(payload.Options.BatchSize)
+			}
+		case *schema_service.ImportSchemasRequest_Schema:
+			index := len(results)
+			results = append(results, nil)
+			summary.Received++
+
+			// Broken field rules make the schema invalid, not the import
+			if violations := Validate(payload.Schema); len(violations) > 0 {
+				record(&schema_service.ImportItemResult{
+					Index:       int32(index),
+					Outcome:     schema_service.ImportOutcome_IMPORT_OUTCOME_INVALID,
+					SchemaName:  payload.Schema.SchemaName,
+					Error:       StatusFromError(validationError(violations)).Proto(),
+					Diagnostics: domain.DiagnosticsToGRPC(ruleDiagnostics(violations, payload.Schema.Tasks)),
+				}, domain.ImportInvalid)
+				continue
+			}
+
+			batch = append(batch, domain.ImportItem{
+				Index: index,
+				Schema: domain.Schema{
+					AuthorID:   payload.Schema.AuthorId,
+					SchemaName: payload.Schema.SchemaName,
+					Tasks:      domain.TasksFromGRPC(payload.Schema.Tasks),
+				},
+			})
+			if len(batch) >= batchSize {
+				if err := commit(); err != nil {
+					return err
+				}
+			}
+		default:
+			return domain.InvalidArgumentError("EMPTY_MESSAGE", nil, "import message carries neither options nor a schema")
+		}
+	}
+	if err := commit(); err != nil {
+		return err
+	}
+
+	// Drop the slots of the schemas left out when the import was aborted
+	received := results[:0]
+	for _, result := range results {
+		if result != nil {
+			received = append(received, result)
+		}
+	}
+
+	// Create and send gRPC response object
+	response := &schema_service.ImportSchemasResponse{
+		Summary: summary,
+		Results: received,
+	}
+
+	fmt.Println("END ImportSchemas API")
+	return stream.SendAndClose(response)
+}
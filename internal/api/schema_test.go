@@ -2,14 +2,19 @@ package api_test
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"io"
 	"reflect"
 	"server/internal/api"
 	"server/internal/domain"
 	schema_service "server/proto"
+	"strings"
 	"testing"
 	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -24,6 +29,7 @@ var domain_schema domain.Schema = domain.Schema{
 	CreatedAt:  now,
 	UpdatedAt:  now,
 	Tasks:      []domain.Task{},
+	State:      domain.StateDraft,
 }
 var domain_schema_2 domain.Schema = domain.Schema{
 	SchemaID:   schema_id + "2",
@@ -32,6 +38,7 @@ var domain_schema_2 domain.Schema = domain.Schema{
 	CreatedAt:  now,
 	UpdatedAt:  now,
 	Tasks:      []domain.Task{},
+	State:      domain.StatePublished,
 }
 var grpc_schema schema_service.Schema = *domain.SchemaToGRPC(&domain_schema)
 var grpc_schema_2 schema_service.Schema = *domain.SchemaToGRPC(&domain_schema_2)
@@ -76,9 +83,9 @@ var task2 schema_service.Task = schema_service.Task{
 
 type MockSchemaHandler struct{}
 
-func (msh *MockSchemaHandler) Create(authorID string, schemaName string, tasks []domain.Task) (domain.Schema, error) {
+func (msh *MockSchemaHandler) Create(ctx context.Context, authorID string, schemaName string, tasks []domain.Task, assignTaskIDs bool) (domain.Schema, error) {
 	if schemaName == "UsedSchemaName" {
-		return domain.Schema{}, fmt.Errorf("schema with name '%s' already exists", schemaName)
+		return domain.Schema{}, domain.SchemaNameTakenError(schemaName)
 	}
 
 	schema := domain.Schema{
@@ -93,13 +100,20 @@ func (msh *MockSchemaHandler) Create(authorID string, schemaName string, tasks [
 	return schema, nil
 }
 
-func (msh *MockSchemaHandler) GetAll() ([]domain.Schema, error) {
-	return []domain.Schema{domain_schema, domain_schema_2}, nil
+func (msh *MockSchemaHandler) GetAll(ctx context.Context, states []domain.SchemaState) ([]domain.Schema, error) {
+	var schemas []domain.Schema
+	for _, schema := range []domain.Schema{domain_schema, domain_schema_2} {
+		if schema.InStates(states) {
+			schemas = append(schemas, schema)
+		}
+	}
+
+	return schemas, nil
 }
 
-func (msh *MockSchemaHandler) GetByID(id string) (domain.Schema, error) {
+func (msh *MockSchemaHandler) GetByID(ctx context.Context, id string) (domain.Schema, error) {
 	if id == "NotPresentSchemaID" {
-		return domain.Schema{}, fmt.Errorf("schema with id=<%s> not found", id)
+		return domain.Schema{}, domain.SchemaNotFoundError(id)
 	}
 
 	schema := domain.Schema{
@@ -114,14 +128,371 @@ func (msh *MockSchemaHandler) GetByID(id string) (domain.Schema, error) {
 	return schema, nil
 }
 
-func (msh *MockSchemaHandler) DeleteByID(id string) error {
+func (msh *MockSchemaHandler) DeleteByID(ctx context.Context, id string) error {
 	if id == "NotPresentSchemaID" {
-		return fmt.Errorf("schema with id=<%s> not found", id)
+		return domain.SchemaNotFoundError(id)
 	}
 
 	return nil
 }
 
+func (msh *MockSchemaHandler) Search(ctx context.Context, query string, limit int) ([]domain.SearchResult, error) {
+	if query == "" {
+		return nil, domain.InvalidArgumentError("EMPTY_QUERY", nil, "search query must not be empty")
+	}
+
+	result := domain.SearchResult{
+		Schema: domain_schema,
+		Score:  1.5,
+		Matches: []domain.SearchMatch{
+			{Field: domain.SearchFieldTaskName, TaskPath: []int64{2, 3}, Snippet: "<em>Task</em> 3"},
+		},
+	}
+
+	return []domain.SearchResult{result}, nil
+}
+
+func (msh *MockSchemaHandler) GetByIDs(ctx context.Context, ids []string) ([]domain.SchemaResult, error) {
+	var results []domain.SchemaResult
+	for _, id := range ids {
+		schema, err := msh.GetByID(ctx, id)
+		results = append(results, domain.SchemaResult{SchemaID: id, Schema: schema, Err: err})
+	}
+
+	return results, nil
+}
+
+func (msh *MockSchemaHandler) DeleteByIDs(ctx context.Context, ids []string) ([]domain.SchemaResult, error) {
+	var results []domain.SchemaResult
+	for _, id := range ids {
+		results = append(results, domain.SchemaResult{SchemaID: id, Err: msh.DeleteByID(ctx, id)})
+	}
+
+	return results, nil
+}
+
+type MockEventStream struct {
+	events chan domain.SchemaEvent
+	err    error
+	closed bool
+}
+
+func (mes *MockEventStream) Events() <-chan domain.SchemaEvent { return mes.events }
+func (mes *MockEventStream) Err() error                        { return mes.err }
+func (mes *MockEventStream) Close()                            { mes.closed = true }
+
+var mockEventStream *MockEventStream
+
+func (msh *MockSchemaHandler) Watch(ctx context.Context, fromRevision int64, filter domain.EventFilter) (domain.EventStream, error) {
+	if filter.AuthorID == "NotPresentAuthorID" {
+		return nil, domain.InvalidArgumentError("UNKNOWN_AUTHOR", nil, "unknown author")
+	}
+
+	// Replay two events and end the feed as a slow consumer would
+	mockEventStream = &MockEventStream{
+		events: make(chan domain.SchemaEvent, 2),
+		err:    domain.NewError(domain.ErrResourceExhausted, "SLOW_CONSUMER", nil, "watcher fell behind"),
+	}
+	mockEventStream.events <- domain.SchemaEvent{Revision: fromRevision + 1, Type: domain.EventCreated, SchemaID: schema_id, Schema: domain_schema, Timestamp: now}
+	mockEventStream.events <- domain.SchemaEvent{Revision: fromRevision + 2, Type: domain.EventDeleted, SchemaID: schema_id, Schema: domain_schema, Timestamp: now}
+	close(mockEventStream.events)
+
+	return mockEventStream, nil
+}
+
+func (msh *MockSchemaHandler) Export(ctx context.Context, filter domain.ExportFilter) (domain.Snapshot, error) {
+	snapshot := domain.Snapshot{Revision: 7}
+	for _, schema := range []domain.Schema{domain_schema, domain_schema_2} {
+		if filter.Matches(schema) {
+			snapshot.Schemas = append(snapshot.Schemas, schema)
+		}
+	}
+
+	return snapshot, nil
+}
+
+func (msh *MockSchemaHandler) ChangesSince(ctx context.Context, revision int64, limit int) (domain.ChangeSet, error) {
+	if revision > 100 {
+		return domain.ChangeSet{}, domain.NewError(domain.ErrFailedPrecondition, "REVISION_IN_FUTURE", nil, "revision %d is ahead", revision)
+	}
+
+	changes := domain.ChangeSet{
+		Schemas:    []domain.Schema{domain_schema},
+		Tombstones: []domain.Tombstone{{SchemaID: "deletedSchemaID", Revision: revision + 2, DeletedAt: now}},
+		Revision:   revision + 2,
+		HasMore:    true,
+	}
+
+	return changes, nil
+}
+
+func (msh *MockSchemaHandler) Clone(ctx context.Context, sourceID string, authorID string, schemaName string, renumberTasks bool) (domain.Schema, error) {
+	if sourceID == "NotPresentSchemaID" {
+		return domain.Schema{}, domain.SchemaNotFoundError(sourceID)
+	}
+
+	schema := domain_schema
+	schema.SchemaID = "clonedSchemaID"
+	schema.AuthorID = authorID
+	schema.SchemaName = schemaName
+	schema.ParentID = sourceID
+
+	return schema, nil
+}
+
+func (msh *MockSchemaHandler) ListDerived(ctx context.Context, id string, transitive bool) ([]domain.Schema, error) {
+	if id == "NotPresentSchemaID" {
+		return nil, domain.SchemaNotFoundError(id)
+	}
+
+	derived := domain_schema
+	derived.ParentID = id
+	schemas := []domain.Schema{derived}
+	if transitive {
+		grandchild := domain_schema
+		grandchild.ParentID = derived.SchemaID
+		schemas = append(schemas, grandchild)
+	}
+
+	return schemas, nil
+}
+
+func (msh *MockSchemaHandler) Validate(ctx context.Context, authorID string, schemaName string, tasks []domain.Task) ([]domain.Diagnostic, error) {
+	var diagnostics []domain.Diagnostic
+	if schemaName == domain_schema.SchemaName {
+		diagnostics = append(diagnostics, domain.Diagnostic{Severity: domain.SeverityError, Code: "SCHEMA_NAME_TAKEN", Field: "schema_name"})
+	}
+	if len(tasks) == 0 {
+		diagnostics = append(diagnostics, domain.Diagnostic{Severity: domain.SeverityWarning, Code: "EMPTY_SCHEMA", Field: "tasks"})
+	}
+
+	return diagnostics, nil
+}
+
+func (msh *MockSchemaHandler) Import(ctx context.Context, items []domain.ImportItem, policy domain.ConflictPolicy) ([]domain.ImportResult, error) {
+	var results []domain.ImportResult
+	var conflict error
+	for _, item := range items {
+		result := domain.ImportResult{Index: item.Index, Outcome: domain.ImportCreated, SchemaID: "imported", SchemaName: item.Schema.SchemaName}
+		if item.Schema.SchemaName == domain_schema.SchemaName {
+			if policy != domain.ConflictFail {
+				result.Outcome = domain.ImportSkipped
+				result.SchemaID = domain_schema.SchemaID
+			} else {
+				result.Outcome = domain.ImportFailed
+				conflict = domain.SchemaNameTakenError(item.Schema.SchemaName)
+				result.Err = conflict
+			}
+		}
+		results = append(results, result)
+	}
+	if conflict != nil {
+		for i := range results {
+			if results[i].Err == nil {
+				results[i].Outcome = domain.ImportFailed
+				results[i].SchemaID = ""
+				results[i].Err = domain.NewError(domain.ErrAborted, "IMPORT_ABORTED", nil, "import aborted")
+			}
+		}
+	}
+
+	return results, conflict
+}
+
+func (msh *MockSchemaHandler) GetByName(ctx context.Context, name string) (domain.Schema, error) {
+	if name != domain_schema.SchemaName {
+		return domain.Schema{}, domain.SchemaNameNotFoundError(name)
+	}
+
+	return domain_schema, nil
+}
+
+func (msh *MockSchemaHandler) ListByAuthor(ctx context.Context, authorID string, states []domain.SchemaState) ([]domain.Schema, error) {
+	var schemas []domain.Schema
+	for _, schema := range []domain.Schema{domain_schema, domain_schema_2} {
+		if schema.AuthorID == authorID && schema.InStates(states) {
+			schemas = append(schemas, schema)
+		}
+	}
+
+	return schemas, nil
+}
+
+func (msh *MockSchemaHandler) GetBySlug(ctx context.Context, slug string) (domain.Schema, bool, error) {
+	if slug != "schemaname" && slug != "former-name" {
+		return domain.Schema{}, false, domain.SlugNotFoundError(slug)
+	}
+
+	schema := domain_schema
+	schema.Slug = "schemaname"
+
+	return schema, slug == "former-name", nil
+}
+
+func (msh *MockSchemaHandler) Rename(ctx context.Context, id string, name string) (domain.Schema, error) {
+	if name == "UsedSchemaName" {
+		return domain.Schema{}, domain.SchemaNameTakenError(name)
+	}
+
+	schema := domain_schema
+	schema.SchemaID = id
+	schema.SchemaName = name
+	schema.Slug = domain.Slugify(name)
+
+	return schema, nil
+}
+
+func (msh *MockSchemaHandler) Update(ctx context.Context, id string, tasks []domain.Task, assignTaskIDs bool) (domain.Schema, error) {
+	if id == "NotPresentSchemaID" {
+		return domain.Schema{}, domain.SchemaNotFoundError(id)
+	}
+
+	schema := domain_schema
+	schema.SchemaID = id
+	schema.Tasks = tasks
+
+	return schema, nil
+}
+
+var publishedVersion = domain.SchemaVersion{
+	Version:     1,
+	SchemaName:  domain_schema.SchemaName,
+	Tasks:       domain_schema.Tasks,
+	PublishedAt: now,
+	Revision:    7,
+}
+
+func (msh *MockSchemaHandler) Publish(ctx context.Context, id string) (domain.SchemaVersion, error) {
+	if id == "NotPresentSchemaID" {
+		return domain.SchemaVersion{}, domain.SchemaNotFoundError(id)
+	}
+
+	return publishedVersion, nil
+}
+
+func (msh *MockSchemaHandler) ListVersions(ctx context.Context, id string) ([]domain.SchemaVersion, error) {
+	if id == "NotPresentSchemaID" {
+		return nil, domain.SchemaNotFoundError(id)
+	}
+
+	second := publishedVersion
+	second.Version = 2
+	return []domain.SchemaVersion{publishedVersion, second}, nil
+}
+
+func (msh *MockSchemaHandler) Transition(ctx context.Context, id string, state domain.SchemaState, comment string) (domain.Schema, error) {
+	if state == domain.StateDeprecated {
+		return domain.Schema{}, domain.CheckTransition(domain_schema.State, state)
+	}
+
+	schema := domain_schema
+	schema.SchemaID = id
+	schema.StateHistory = []domain.StateChange{{From: schema.State, To: state, Actor: "caller", Time: now, Comment: comment}}
+	schema.State = state
+
+	return schema, nil
+}
+
+func (msh *MockSchemaHandler) RequestReview(ctx context.Context, id string, comment string) (domain.Schema, error) {
+	return msh.Transition(ctx, id, domain.StateInReview, comment)
+}
+
+func (msh *MockSchemaHandler) Approve(ctx context.Context, id string, comment string) (domain.Schema, error) {
+	if domain.RequestInfoFrom(ctx).CallerID == domain_schema.AuthorID {
+		return domain.Schema{}, domain.NewError(domain.ErrPermissionDenied, "SELF_APPROVAL", nil, "schemas cannot be approved by their author")
+	}
+
+	schema := domain_schema
+	schema.SchemaID = id
+	schema.State = domain.StateApproved
+	schema.Reviews = []domain.Review{{
+		Number:      1,
+		RequestedBy: domain_schema.AuthorID,
+		RequestedAt: now,
+		Policy:      domain.ReviewPolicy{MinApprovals: 1, RequiredRoles: []string{"pharmacist"}},
+		Status:      domain.ReviewApproved,
+		Records: []domain.ReviewRecord{{
+			Reviewer: domain.RequestInfoFrom(ctx).CallerID,
+			Roles:    domain.RequestInfoFrom(ctx).CallerRoles,
+			Decision: domain.DecisionApprove,
+			Comment:  comment,
+			Time:     now,
+		}},
+	}}
+
+	return schema, nil
+}
+
+func (msh *MockSchemaHandler) Reject(ctx context.Context, id string, comment string) (domain.Schema, error) {
+	return domain.Schema{}, domain.NewError(domain.ErrFailedPrecondition, "NOT_IN_REVIEW", nil, "schema with id=<%s> is not in review", id)
+}
+
+func (msh *MockSchemaHandler) Diff(ctx context.Context, fromID string, fromVersion int64, toID string, toVersion int64) (domain.SchemaDiff, error) {
+	if fromID == "NotPresentSchemaID" {
+		return domain.SchemaDiff{}, domain.SchemaNotFoundError(fromID)
+	}
+
+	to := domain_schema
+	to.SchemaName = "renamed"
+	to.Tasks = []domain.Task{{ID: 1, Name: "Task 1", Status: domain.TaskNotStarted}}
+	return domain.DiffSchemas(domain_schema, to)
+}
+
+func (msh *MockSchemaHandler) GetVersion(ctx context.Context, id string, version int64) (domain.SchemaVersion, error) {
+	if version != 1 {
+		return domain.SchemaVersion{}, domain.SchemaVersionNotFoundError(id, version)
+	}
+
+	return publishedVersion, nil
+}
+
+type MockExportSchemasServer struct {
+	grpc.ServerStream
+	sent []*schema_service.ExportSchemasResponse
+}
+
+func (mes *MockExportSchemasServer) Context() context.Context { return context.Background() }
+
+func (mes *MockExportSchemasServer) Send(response *schema_service.ExportSchemasResponse) error {
+	mes.sent = append(mes.sent, response)
+	return nil
+}
+
+type MockImportSchemasServer struct {
+	grpc.ServerStream
+	requests []*schema_service.ImportSchemasRequest
+	response *schema_service.ImportSchemasResponse
+}
+
+func (mis *MockImportSchemasServer) Context() context.Context { return context.Background() }
+
+func (mis *MockImportSchemasServer) Recv() (*schema_service.ImportSchemasRequest, error) {
+	if len(mis.requests) == 0 {
+		return nil, io.EOF
+	}
+	request := mis.requests[0]
+	mis.requests = mis.requests[1:]
+	return request, nil
+}
+
+func (mis *MockImportSchemasServer) SendAndClose(response *schema_service.ImportSchemasResponse) error {
+	mis.response = response
+	return nil
+}
+
+type MockWatchSchemasServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*schema_service.WatchSchemasResponse
+}
+
+func (mws *MockWatchSchemasServer) Context() context.Context { return mws.ctx }
+
+func (mws *MockWatchSchemasServer) Send(response *schema_service.WatchSchemasResponse) error {
+	mws.sent = append(mws.sent, response)
+	return nil
+}
+
 // Tests
 
 func TestCreateSchema(t *testing.T) {
@@ -228,6 +599,15 @@ func TestGetAllSchemas(t *testing.T) {
 			t.Errorf("Expected schemas %+v, got %+v", expectedSchemas, schemas)
 		}
 	})
+
+	t.Run("FilterByState", func(t *testing.T) {
+		request := schema_service.GetAllSchemasRequest{States: []schema_service.SchemaState{schema_service.SchemaState_SCHEMA_STATE_DRAFT}}
+		response, err := apiHandler.GetAllSchemas(context.Background(), &request)
+
+		if err != nil || len(response.Schemas) != 1 || response.Schemas[0].SchemaId != grpc_schema.SchemaId {
+			t.Errorf("Expected only the draft, got %+v (%v)", response, err)
+		}
+	})
 }
 
 func TestGetSchemaByID(t *testing.T) {
@@ -301,3 +681,649 @@ func TestDeleteSchemaByID(t *testing.T) {
 		}
 	})
 }
+
+func TestSearchSchemas(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("EmptyQuery", func(t *testing.T) {
+		request := schema_service.SearchSchemasRequest{}
+		_, err := apiHandler.SearchSchemas(context.Background(), &request)
+
+		if err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+
+	t.Run("Matches", func(t *testing.T) {
+		request := schema_service.SearchSchemasRequest{Query: "task"}
+		response, err := apiHandler.SearchSchemas(context.Background(), &request)
+
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+
+		if len(response.Results) != 1 {
+			t.Fatalf("Expected 1 result, got %d", len(response.Results))
+		}
+
+		result := response.Results[0]
+
+		if result.Schema.SchemaId != schema_id {
+			t.Errorf("Expected SchemaId='%s', found: %s", schema_id, result.Schema.SchemaId)
+		}
+
+		if len(result.Matches) != 1 || result.Matches[0].Field != schema_service.SearchField_SEARCH_FIELD_TASK_NAME {
+			t.Errorf("Expected one TASK_NAME match, got %+v", result.Matches)
+		}
+
+		if !reflect.DeepEqual(result.Matches[0].TaskPath, []int64{2, 3}) {
+			t.Errorf("Expected task path [2 3], got %v", result.Matches[0].TaskPath)
+		}
+	})
+}
+
+func TestBatchGetSchemas(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("PerItemResults", func(t *testing.T) {
+		request := schema_service.BatchGetSchemasRequest{
+			SchemaIds: []string{schema_id, "NotPresentSchemaID"},
+		}
+		response, err := apiHandler.BatchGetSchemas(context.Background(), &request)
+
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+
+		if len(response.Results) != 2 {
+			t.Fatalf("Expected 2 results, got %d", len(response.Results))
+		}
+
+		if response.Results[0].GetSchema().GetSchemaId() != schema_id {
+			t.Errorf("Expected schema %s, got %+v", schema_id, response.Results[0])
+		}
+
+		notFound := response.Results[1]
+		if notFound.SchemaId != "NotPresentSchemaID" || codes.Code(notFound.GetError().GetCode()) != codes.NotFound {
+			t.Errorf("Expected NOT_FOUND for missing id, got %+v", notFound)
+		}
+	})
+}
+
+func TestBatchDeleteSchemas(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("PerItemResults", func(t *testing.T) {
+		request := schema_service.BatchDeleteSchemasRequest{
+			SchemaIds: []string{schema_id, "NotPresentSchemaID"},
+		}
+		response, err := apiHandler.BatchDeleteSchemas(context.Background(), &request)
+
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+
+		if len(response.Results) != 2 {
+			t.Fatalf("Expected 2 results, got %d", len(response.Results))
+		}
+
+		if response.Results[0].Error != nil {
+			t.Errorf("Expected deletion to succeed, got %+v", response.Results[0].Error)
+		}
+
+		if codes.Code(response.Results[1].GetError().GetCode()) != codes.NotFound {
+			t.Errorf("Expected NOT_FOUND for missing id, got %+v", response.Results[1])
+		}
+	})
+}
+
+func TestWatchSchemas(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("InvalidFilter", func(t *testing.T) {
+		request := schema_service.WatchSchemasRequest{AuthorId: "NotPresentAuthorID"}
+		stream := &MockWatchSchemasServer{ctx: context.Background()}
+		err := apiHandler.WatchSchemas(&request, stream)
+
+		if err == nil {
+			t.Errorf("Expected error, got nil")
+		}
+	})
+
+	t.Run("ForwardsEventsUntilFeedEnds", func(t *testing.T) {
+		request := schema_service.WatchSchemasRequest{FromRevision: 10}
+		stream := &MockWatchSchemasServer{ctx: context.Background()}
+		err := apiHandler.WatchSchemas(&request, stream)
+
+		if api.StatusFromError(err).Code() != codes.ResourceExhausted {
+			t.Errorf("Expected ResourceExhausted, got %v", err)
+		}
+
+		if len(stream.sent) != 2 {
+			t.Fatalf("Expected 2 events, got %d", len(stream.sent))
+		}
+
+		created := stream.sent[0].Event
+		if created.Revision != 11 || created.Type != schema_service.SchemaEventType_SCHEMA_EVENT_TYPE_CREATED || created.SchemaId != schema_id {
+			t.Errorf("Unexpected event %+v", created)
+		}
+
+		if stream.sent[1].Event.Type != schema_service.SchemaEventType_SCHEMA_EVENT_TYPE_DELETED {
+			t.Errorf("Expected DELETED event, got %+v", stream.sent[1].Event)
+		}
+
+		if !mockEventStream.closed {
+			t.Errorf("Expected the event stream to be closed")
+		}
+	})
+}
+
+func TestExportSchemas(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("AllSchemas", func(t *testing.T) {
+		stream := &MockExportSchemasServer{}
+		err := apiHandler.ExportSchemas(&schema_service.ExportSchemasRequest{}, stream)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(stream.sent) != 3 || stream.sent[2].Schema.SchemaId != domain_schema_2.SchemaID {
+			t.Fatalf("Expected the snapshot then both schemas one per message, got %+v", stream.sent)
+		}
+		if stream.sent[0].Schema != nil || stream.sent[0].SnapshotRevision != 7 || stream.sent[0].Total != 2 {
+			t.Errorf("Unexpected snapshot message %+v", stream.sent[0])
+		}
+		if stream.sent[1].SnapshotRevision != 0 || stream.sent[1].Total != 0 {
+			t.Errorf("Expected the snapshot to be sent once, got %+v", stream.sent[1])
+		}
+	})
+
+	t.Run("UpdatedSince", func(t *testing.T) {
+		request := schema_service.ExportSchemasRequest{UpdatedSince: timestamppb.New(now.Add(time.Second))}
+		stream := &MockExportSchemasServer{}
+		err := apiHandler.ExportSchemas(&request, stream)
+
+		if err != nil || len(stream.sent) != 1 || stream.sent[0].SnapshotRevision != 7 || stream.sent[0].Total != 0 {
+			t.Errorf("Expected an empty export with its snapshot revision, got %+v (%v)", stream.sent, err)
+		}
+	})
+}
+
+func TestGetChangesSince(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("RevisionInFuture", func(t *testing.T) {
+		request := schema_service.GetChangesSinceRequest{Revision: 101}
+		_, err := apiHandler.GetChangesSince(context.Background(), &request)
+
+		if api.StatusFromError(err).Code() != codes.FailedPrecondition {
+			t.Errorf("Expected FailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("ReturnsChanges", func(t *testing.T) {
+		request := schema_service.GetChangesSinceRequest{Revision: 10, Limit: 2}
+		response, err := apiHandler.GetChangesSince(context.Background(), &request)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(response.Schemas) != 1 || response.Schemas[0].SchemaId != schema_id {
+			t.Errorf("Unexpected schemas %+v", response.Schemas)
+		}
+
+		if len(response.Tombstones) != 1 || response.Tombstones[0].Revision != 12 || response.Tombstones[0].DeletedAt == nil {
+			t.Errorf("Unexpected tombstones %+v", response.Tombstones)
+		}
+
+		if response.Revision != 12 || !response.HasMore {
+			t.Errorf("Unexpected high-water mark %d, has_more=%v", response.Revision, response.HasMore)
+		}
+	})
+}
+
+func TestCloneSchema(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("SourceNotFound", func(t *testing.T) {
+		request := schema_service.CloneSchemaRequest{SourceSchemaId: "NotPresentSchemaID", AuthorId: "author", SchemaName: "copy"}
+		_, err := apiHandler.CloneSchema(context.Background(), &request)
+
+		if api.StatusFromError(err).Code() != codes.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("Clones", func(t *testing.T) {
+		request := schema_service.CloneSchemaRequest{SourceSchemaId: schema_id, AuthorId: "author", SchemaName: "copy", RenumberTaskIds: true}
+		response, err := apiHandler.CloneSchema(context.Background(), &request)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		cloned := response.Schema
+		if cloned.SchemaName != "copy" || cloned.AuthorId != "author" || cloned.ParentSchemaId != schema_id {
+			t.Errorf("Unexpected clone %+v", cloned)
+		}
+	})
+}
+
+func TestListDerivedSchemas(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("SchemaNotFound", func(t *testing.T) {
+		request := schema_service.ListDerivedSchemasRequest{SchemaId: "NotPresentSchemaID"}
+		_, err := apiHandler.ListDerivedSchemas(context.Background(), &request)
+
+		if api.StatusFromError(err).Code() != codes.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("Transitive", func(t *testing.T) {
+		request := schema_service.ListDerivedSchemasRequest{SchemaId: schema_id, Transitive: true}
+		response, err := apiHandler.ListDerivedSchemas(context.Background(), &request)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(response.Schemas) != 2 || response.Schemas[0].ParentSchemaId != schema_id {
+			t.Errorf("Unexpected derivatives %+v", response.Schemas)
+		}
+	})
+}
+
+func TestValidateSchema(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("WarningsOnly", func(t *testing.T) {
+		request := schema_service.ValidateSchemaRequest{AuthorId: "author", SchemaName: "draft"}
+		response, err := apiHandler.ValidateSchema(context.Background(), &request)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if !response.Valid || len(response.Diagnostics) != 1 || response.Diagnostics[0].Severity != schema_service.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_WARNING {
+			t.Errorf("Expected a valid draft with one warning, got %+v", response)
+		}
+	})
+
+	t.Run("ReportsFieldRules", func(t *testing.T) {
+		child := validTask(3)
+		child.Name = ""
+		parent := validTask(2)
+		parent.Children = []*schema_service.Task{child}
+		request := schema_service.ValidateSchemaRequest{
+			AuthorId:   "author",
+			SchemaName: domain_schema.SchemaName,
+			Tasks:      []*schema_service.Task{validTask(1), parent},
+		}
+		response, err := apiHandler.ValidateSchema(context.Background(), &request)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if response.Valid || len(response.Diagnostics) != 2 {
+			t.Fatalf("Expected two errors, got %+v", response.Diagnostics)
+		}
+
+		invalid := response.Diagnostics[0]
+		if invalid.Code != "INVALID_FIELD" || invalid.Field != "tasks[1].children[0].name" || !reflect.DeepEqual(invalid.TaskPath, []int64{2, 3}) {
+			t.Errorf("Unexpected diagnostic %+v", invalid)
+		}
+
+		if response.Diagnostics[1].Code != "SCHEMA_NAME_TAKEN" {
+			t.Errorf("Expected SCHEMA_NAME_TAKEN, got %+v", response.Diagnostics[1])
+		}
+	})
+
+	t.Run("NotRejectedByInterceptor", func(t *testing.T) {
+		request := &schema_service.ValidateSchemaRequest{}
+		info := &grpc.UnaryServerInfo{FullMethod: "/alt_team.schema_service.SchemaService/ValidateSchema"}
+		handler := func(ctx context.Context, req any) (any, error) {
+			return apiHandler.ValidateSchema(ctx, req.(*schema_service.ValidateSchemaRequest))
+		}
+		response, err := api.ValidationUnaryInterceptor(context.Background(), request, info, handler)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if response.(*schema_service.ValidateSchemaResponse).Valid {
+			t.Errorf("Expected empty fields to be reported")
+		}
+	})
+}
+
+func TestImportSchemas(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	options := func(policy schema_service.ImportConflictPolicy, batchSize int32) *schema_service.ImportSchemasRequest {
+		return &schema_service.ImportSchemasRequest{Payload: &schema_service.ImportSchemasRequest_Options{
+			Options: &schema_service.ImportOptions{ConflictPolicy: policy, BatchSize: batchSize},
+		}}
+	}
+	imported := func(name string, tasks ...*schema_service.Task) *schema_service.ImportSchemasRequest {
+		return &schema_service.ImportSchemasRequest{Payload: &schema_service.ImportSchemasRequest_Schema{
+			Schema: &schema_service.ImportedSchema{AuthorId: "author", SchemaName: name, Tasks: tasks},
+		}}
+	}
+
+	t.Run("Summary", func(t *testing.T) {
+		invalid := validTask(2)
+		invalid.Name = ""
+		stream := &MockImportSchemasServer{requests: []*schema_service.ImportSchemasRequest{
+			options(schema_service.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_SKIP, 1),
+			imported("first", validTask(1)),
+			imported("broken", invalid),
+			imported(domain_schema.SchemaName, validTask(1)),
+		}}
+		if err := apiHandler.ImportSchemas(stream); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		summary := stream.response.Summary
+		if summary.Received != 3 || summary.Created != 1 || summary.Invalid != 1 || summary.Skipped != 1 || summary.Aborted {
+			t.Errorf("Unexpected summary %+v", summary)
+		}
+		results := stream.response.Results
+		if len(results) != 3 || results[1].Index != 1 || results[1].Outcome != schema_service.ImportOutcome_IMPORT_OUTCOME_INVALID {
+			t.Fatalf("Unexpected results %+v", results)
+		}
+		if results[1].Error.Code != int32(codes.InvalidArgument) || results[1].Diagnostics[0].Field != "tasks[0].name" {
+			t.Errorf("Expected invalid name to be reported, got %+v", results[1])
+		}
+	})
+
+	t.Run("AbortsOnConflict", func(t *testing.T) {
+		stream := &MockImportSchemasServer{requests: []*schema_service.ImportSchemasRequest{
+			options(schema_service.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_FAIL, 2),
+			imported("first", validTask(1)),
+			imported(domain_schema.SchemaName, validTask(1)),
+			imported("never read", validTask(1)),
+		}}
+		if err := apiHandler.ImportSchemas(stream); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		summary := stream.response.Summary
+		if !summary.Aborted || summary.Received != 2 || summary.Failed != 2 || summary.Created != 0 {
+			t.Errorf("Unexpected summary %+v", summary)
+		}
+		results := stream.response.Results
+		if results[0].Error.Code != int32(codes.Aborted) || results[1].Error.Code != int32(codes.AlreadyExists) {
+			t.Errorf("Unexpected results %+v", results)
+		}
+	})
+
+	t.Run("OptionsAfterSchemas", func(t *testing.T) {
+		stream := &MockImportSchemasServer{requests: []*schema_service.ImportSchemasRequest{
+			imported("first", validTask(1)),
+			options(schema_service.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_SKIP, 0),
+		}}
+		err := apiHandler.ImportSchemas(stream)
+
+		if api.StatusFromError(err).Code() != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument, got %v", err)
+		}
+	})
+}
+
+func TestGetSchemaByName(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("NotPresentName", func(t *testing.T) {
+		_, err := apiHandler.GetSchemaByName(context.Background(), &schema_service.GetSchemaByNameRequest{SchemaName: "NotPresentName"})
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected a not found error, got %v", err)
+		}
+	})
+
+	t.Run("PresentName", func(t *testing.T) {
+		response, err := apiHandler.GetSchemaByName(context.Background(), &schema_service.GetSchemaByNameRequest{SchemaName: domain_schema.SchemaName})
+
+		if err != nil || response.Schema.SchemaId != schema_id {
+			t.Errorf("Expected schema %s, got %+v (%v)", schema_id, response, err)
+		}
+	})
+}
+
+func TestListSchemasByAuthor(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	response, err := apiHandler.ListSchemasByAuthor(context.Background(), &schema_service.ListSchemasByAuthorRequest{AuthorId: domain_schema_2.AuthorID})
+
+	if err != nil || len(response.Schemas) != 1 || response.Schemas[0].SchemaId != domain_schema_2.SchemaID {
+		t.Errorf("Expected the schema of %s, got %+v (%v)", domain_schema_2.AuthorID, response, err)
+	}
+}
+
+func TestGetSchemaBySlug(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("Alias", func(t *testing.T) {
+		response, err := apiHandler.GetSchemaBySlug(context.Background(), &schema_service.GetSchemaBySlugRequest{Slug: "former-name"})
+
+		if err != nil || !response.Alias || response.Schema.Slug != "schemaname" {
+			t.Errorf("Expected the schema under its current slug, got %+v (%v)", response, err)
+		}
+	})
+
+	t.Run("NotPresentSlug", func(t *testing.T) {
+		_, err := apiHandler.GetSchemaBySlug(context.Background(), &schema_service.GetSchemaBySlugRequest{Slug: "missing"})
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected a not found error, got %v", err)
+		}
+	})
+}
+
+func TestRenameSchema(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("UsedSchemaName", func(t *testing.T) {
+		_, err := apiHandler.RenameSchema(context.Background(), &schema_service.RenameSchemaRequest{SchemaId: schema_id, SchemaName: "UsedSchemaName"})
+
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("Expected an already exists error, got %v", err)
+		}
+	})
+
+	t.Run("Renamed", func(t *testing.T) {
+		response, err := apiHandler.RenameSchema(context.Background(), &schema_service.RenameSchemaRequest{SchemaId: schema_id, SchemaName: "New Name"})
+
+		if err != nil || response.Schema.SchemaName != "New Name" || response.Schema.Slug != "new-name" {
+			t.Errorf("Expected the new name and slug, got %+v (%v)", response, err)
+		}
+	})
+}
+
+func TestUpdateSchema(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("NotPresentSchemaID", func(t *testing.T) {
+		_, err := apiHandler.UpdateSchema(context.Background(), &schema_service.UpdateSchemaRequest{SchemaId: "NotPresentSchemaID"})
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected a not found error, got %v", err)
+		}
+	})
+
+	t.Run("Updated", func(t *testing.T) {
+		request := &schema_service.UpdateSchemaRequest{SchemaId: schema_id, Tasks: []*schema_service.Task{&task1}}
+		response, err := apiHandler.UpdateSchema(context.Background(), request)
+
+		if err != nil || len(response.Schema.Tasks) != 1 || response.Schema.Tasks[0].Id != task1.Id {
+			t.Errorf("Expected the new tasks, got %+v (%v)", response, err)
+		}
+	})
+}
+
+func TestSchemaVersions(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("Publish", func(t *testing.T) {
+		response, err := apiHandler.PublishSchema(context.Background(), &schema_service.PublishSchemaRequest{SchemaId: schema_id})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		version := response.Version
+		if version.SchemaId != schema_id || version.Version != 1 || version.Revision != 7 || len(version.Tasks) != len(domain_schema.Tasks) {
+			t.Errorf("Unexpected version %+v", version)
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		response, err := apiHandler.ListSchemaVersions(context.Background(), &schema_service.ListSchemaVersionsRequest{SchemaId: schema_id})
+
+		if err != nil || len(response.Versions) != 2 || response.Versions[1].Version != 2 {
+			t.Errorf("Expected versions 1 and 2, got %+v (%v)", response, err)
+		}
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		response, err := apiHandler.GetSchemaVersion(context.Background(), &schema_service.GetSchemaVersionRequest{SchemaId: schema_id, Version: 1})
+
+		if err != nil || response.Version.Version != 1 || response.Version.SchemaName != domain_schema.SchemaName {
+			t.Errorf("Expected version 1, got %+v (%v)", response, err)
+		}
+	})
+
+	t.Run("GetNotPublished", func(t *testing.T) {
+		_, err := apiHandler.GetSchemaVersion(context.Background(), &schema_service.GetSchemaVersionRequest{SchemaId: schema_id, Version: 5})
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected a not found error, got %v", err)
+		}
+	})
+}
+
+func TestTransitionSchema(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("Transitioned", func(t *testing.T) {
+		request := &schema_service.TransitionSchemaRequest{SchemaId: schema_id, State: schema_service.SchemaState_SCHEMA_STATE_PUBLISHED, Comment: "ship it"}
+		response, err := apiHandler.TransitionSchema(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		history := response.Schema.StateHistory
+		if response.Schema.State != schema_service.SchemaState_SCHEMA_STATE_PUBLISHED || len(history) != 1 ||
+			history[0].From != schema_service.SchemaState_SCHEMA_STATE_DRAFT || history[0].Comment != "ship it" {
+			t.Errorf("Unexpected schema %+v", response.Schema)
+		}
+	})
+
+	t.Run("InvalidTransition", func(t *testing.T) {
+		request := &schema_service.TransitionSchemaRequest{SchemaId: schema_id, State: schema_service.SchemaState_SCHEMA_STATE_DEPRECATED}
+		_, err := apiHandler.TransitionSchema(context.Background(), request)
+
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected FailedPrecondition, got %v", err)
+		}
+	})
+}
+
+func TestReviewSchema(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+	reviewer := domain.WithRequestInfo(context.Background(), domain.RequestInfo{CallerID: "pharma", CallerRoles: []string{"pharmacist"}})
+
+	t.Run("RequestReview", func(t *testing.T) {
+		response, err := apiHandler.RequestSchemaReview(context.Background(), &schema_service.RequestSchemaReviewRequest{SchemaId: schema_id})
+
+		if err != nil || response.Schema.State != schema_service.SchemaState_SCHEMA_STATE_IN_REVIEW {
+			t.Errorf("Expected the schema to be in review, got %+v (%v)", response, err)
+		}
+	})
+
+	t.Run("Approve", func(t *testing.T) {
+		response, err := apiHandler.ApproveSchema(reviewer, &schema_service.ApproveSchemaRequest{SchemaId: schema_id, Comment: "ok"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		review := response.Schema.Reviews[0]
+		if response.Schema.State != schema_service.SchemaState_SCHEMA_STATE_APPROVED || review.Status != schema_service.ReviewStatus_REVIEW_STATUS_APPROVED ||
+			review.Policy.MinApprovals != 1 || review.Records[0].Reviewer != "pharma" || review.Records[0].Roles[0] != "pharmacist" ||
+			review.Records[0].Decision != schema_service.ReviewDecision_REVIEW_DECISION_APPROVE {
+			t.Errorf("Unexpected schema %+v", response.Schema)
+		}
+	})
+
+	t.Run("SelfApproval", func(t *testing.T) {
+		author := domain.WithRequestInfo(context.Background(), domain.RequestInfo{CallerID: domain_schema.AuthorID})
+		_, err := apiHandler.ApproveSchema(author, &schema_service.ApproveSchemaRequest{SchemaId: schema_id})
+
+		if !errors.Is(err, domain.ErrPermissionDenied) {
+			t.Errorf("Expected ErrPermissionDenied, got %v", err)
+		}
+	})
+
+	t.Run("RejectNotInReview", func(t *testing.T) {
+		_, err := apiHandler.RejectSchema(reviewer, &schema_service.RejectSchemaRequest{SchemaId: schema_id, Comment: "wrong dose"})
+
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected ErrFailedPrecondition, got %v", err)
+		}
+	})
+}
+
+func TestDiffSchemas(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServer{SchemaHandler: mockHandler}
+
+	t.Run("Diff", func(t *testing.T) {
+		response, err := apiHandler.DiffSchemas(context.Background(), &schema_service.DiffSchemasRequest{FromSchemaId: schema_id, ToVersion: 1})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expectedSchemaChanges := []*schema_service.FieldChange{{Field: "schema_name", Before: `"schemaName"`, After: `"renamed"`}}
+		if !reflect.DeepEqual(response.SchemaChanges, expectedSchemaChanges) {
+			t.Errorf("Expected %+v, got %+v", expectedSchemaChanges, response.SchemaChanges)
+		}
+		added := response.TaskChanges
+		if len(added) != 1 || added[0].Kind != schema_service.TaskChangeKind_TASK_CHANGE_KIND_ADDED || added[0].TaskId != 1 || len(added[0].Fields) != 2 {
+			t.Errorf("Expected task 1 to be added, got %+v", added)
+		}
+		if !strings.Contains(response.Text, `+ task 1 "Task 1" added`) {
+			t.Errorf("Unexpected text %q", response.Text)
+		}
+	})
+
+	t.Run("NotPresentSchemaID", func(t *testing.T) {
+		_, err := apiHandler.DiffSchemas(context.Background(), &schema_service.DiffSchemasRequest{FromSchemaId: "NotPresentSchemaID"})
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected a not found error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"server/internal/domain"
+	"strconv"
+	"strings"
+	"time"
+
+	schema_service_v2 "server/proto/v2"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const defaultPageSize = 50
+
+// SchemaServerV2 serves API version 2 with the same handler as SchemaServer,
+// so that both versions share the stored schemas.
+type SchemaServerV2 struct {
+	schema_service_v2.UnimplementedSchemaServiceServer
+	SchemaHandler SchemaHandler
+}
+
+func (s *SchemaServerV2) CreateSchema(ctx context.Context, req *schema_service_v2.CreateSchemaRequest) (*schema_service_v2.Schema, error) {
+	fmt.Println("START CreateSchema API v2")
+
+	// Invoke SchemaHandler for creation
+	schema, err := s.SchemaHandler.Create(ctx, req.AuthorId, req.SchemaName, domain.TasksFromV2(req.Tasks), req.AssignTaskIds)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.Create: ", err)
+		return nil, err
+	}
+
+	fmt.Println("END CreateSchema API v2")
+	return domain.SchemaToV2(&schema), nil
+}
+
+func (s *SchemaServerV2) GetSchema(ctx context.Context, req *schema_service_v2.GetSchemaRequest) (*schema_service_v2.Schema, error) {
+	fmt.Println("START GetSchema API v2")
+
+	// Invoke SchemaHandler for fetching the schema
+	schema, err := s.SchemaHandler.GetByID(ctx, req.SchemaId)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.GetByID: ", err)
+		return nil, err
+	}
+
+	fmt.Println("END GetSchema API v2")
+	return domain.SchemaToV2(&schema), nil
+}
+
+func (s *SchemaServerV2) ListSchemas(ctx context.Context, req *schema_service_v2.ListSchemasRequest) (*schema_service_v2.ListSchemasResponse, error) {
+	fmt.Println("START ListSchemas API v2")
+
+	after, err := parsePageToken(req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	// Invoke SchemaHandler for a snapshot in creation order
+	var filter domain.ExportFilter
+	if req.AuthorId != "" {
+		filter.AuthorIDs = []string{req.AuthorId}
+	}
+	snapshot, err := s.SchemaHandler.Export(ctx, filter)
+	if err != nil {
+		fmt.Println("Error calling SchemaHandler.Export: ", err)
+		return nil, err
+	}
+
+	// Keep the page following the token
+	response := &schema_service_v2.ListSchemasResponse{}
+	for i := range snapshot.Schemas {
+		schema := &snapshot.Schemas[i]
+		if after != nil && !after.before(schema) {
+			continue
+		}
+		if len(response.Schemas) == pageSize {
+			response.NextPageToken = pageTokenOf(&snapshot.Schemas[i-1])
+			break
+		}
+		response.Schemas = append(response.Schemas, domain.SchemaToV2(schema))
+	}
+
+	fmt.Println("END ListSchemas API v2")
+	return response, nil
+}
+
+func (s *SchemaServerV2) DeleteSchema(ctx context.Context, req *schema_service_v2.DeleteSchemaRequest) (*emptypb.Empty, error) {
+	fmt.Println("START DeleteSchema API v2")
+
+	// Invoke SchemaHandler for deleting the schema
+	if err := s.SchemaHandler.DeleteByID(ctx, req.SchemaId); err != nil {
+		fmt.Println("Error calling SchemaHandler.DeleteByID: ", err)
+		return nil, err
+	}
+
+	fmt.Println("END DeleteSchema API v2")
+	return &emptypb.Empty{}, nil
+}
+
+// pageCursor is the last schema of a page, in the creation order of the
+// listing. Pages stay consistent when schemas are created or deleted in
+// between.
+type pageCursor struct {
+	createdAt time.Time
+	schemaID  string
+}
+
+func (c *pageCursor) before(s *domain.Schema) bool {
+	if !c.createdAt.Equal(s.CreatedAt) {
+		return c.createdAt.Before(s.CreatedAt)
+	}
+	return c.schemaID < s.SchemaID
+}
+
+func pageTokenOf(s *domain.Schema) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(s.CreatedAt.UnixNano(), 10) + "/" + s.SchemaID))
+}
+
+func parsePageToken(token string) (*pageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	invalid := domain.InvalidArgumentError("INVALID_PAGE_TOKEN", []domain.FieldViolation{
+		{Field: "page_token", Description: "must be a next_page_token returned by ListSchemas"},
+	}, "invalid page token")
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+	nanos, schemaID, ok := strings.Cut(string(data), "/")
+	if !ok {
+		return nil, invalid
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, invalid
+	}
+	return &pageCursor{createdAt: time.Unix(0, n), schemaID: schemaID}, nil
+}
@@ -0,0 +1,135 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"server/internal/api"
+	"server/internal/domain"
+	schema_service_v2 "server/proto/v2"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var v2Task = schema_service_v2.Task{
+	Id:          1,
+	Level:       1,
+	Name:        "Task 1",
+	Status:      schema_service_v2.TaskStatus_TASK_STATUS_IN_PROGRESS,
+	Responsible: "Doctor1",
+	TimeLimit:   durationpb.New(90 * time.Minute),
+	Comment:     "Task 1 comment",
+	Description: "Check the vitals",
+	Labels:      []string{"triage", "urgent"},
+}
+
+func TestCreateSchemaV2(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServerV2{SchemaHandler: mockHandler}
+
+	t.Run("UsedSchemaName", func(t *testing.T) {
+		request := schema_service_v2.CreateSchemaRequest{AuthorId: "authorID", SchemaName: "UsedSchemaName"}
+		_, err := apiHandler.CreateSchema(context.Background(), &request)
+
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("Expected an already exists error, got %v", err)
+		}
+	})
+
+	t.Run("TaskFields", func(t *testing.T) {
+		request := schema_service_v2.CreateSchemaRequest{
+			AuthorId:   "authorID",
+			SchemaName: "ValidSchemaName",
+			Tasks:      []*schema_service_v2.Task{&v2Task},
+		}
+		schema, err := apiHandler.CreateSchema(context.Background(), &request)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		task := schema.Tasks[0]
+		if task.TimeLimit.AsDuration() != 90*time.Minute || task.Description != v2Task.Description || len(task.Labels) != 2 {
+			t.Errorf("Expected the task fields to be kept, got %+v", task)
+		}
+		if task.Status != schema_service_v2.TaskStatus_TASK_STATUS_IN_PROGRESS || task.Comment != v2Task.Comment {
+			t.Errorf("Expected the status and comment to be kept, got %+v", task)
+		}
+		if !schema.Metadata.CreateTime.AsTime().Equal(now) {
+			t.Errorf("Expected the creation time in the metadata, got %+v", schema.Metadata)
+		}
+	})
+}
+
+func TestGetSchemaV2(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServerV2{SchemaHandler: mockHandler}
+
+	t.Run("NotPresentSchemaID", func(t *testing.T) {
+		_, err := apiHandler.GetSchema(context.Background(), &schema_service_v2.GetSchemaRequest{SchemaId: "NotPresentSchemaID"})
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected a not found error, got %v", err)
+		}
+	})
+
+	t.Run("PresentSchemaID", func(t *testing.T) {
+		schema, err := apiHandler.GetSchema(context.Background(), &schema_service_v2.GetSchemaRequest{SchemaId: schema_id})
+
+		if err != nil || schema.SchemaId != schema_id || schema.SchemaName != domain_schema.SchemaName {
+			t.Errorf("Expected %s, got %+v (%v)", schema_id, schema, err)
+		}
+	})
+}
+
+func TestListSchemasV2(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServerV2{SchemaHandler: mockHandler}
+
+	t.Run("Pages", func(t *testing.T) {
+		first, err := apiHandler.ListSchemas(context.Background(), &schema_service_v2.ListSchemasRequest{PageSize: 1})
+		if err != nil || len(first.Schemas) != 1 || first.Schemas[0].SchemaId != domain_schema.SchemaID || first.NextPageToken == "" {
+			t.Fatalf("Expected the first schema and a page token, got %+v (%v)", first, err)
+		}
+
+		second, err := apiHandler.ListSchemas(context.Background(), &schema_service_v2.ListSchemasRequest{PageSize: 1, PageToken: first.NextPageToken})
+		if err != nil || len(second.Schemas) != 1 || second.Schemas[0].SchemaId != domain_schema_2.SchemaID {
+			t.Fatalf("Expected the second schema, got %+v (%v)", second, err)
+		}
+		if second.NextPageToken != "" {
+			t.Errorf("Expected the last page, got token %q", second.NextPageToken)
+		}
+	})
+
+	t.Run("Author", func(t *testing.T) {
+		response, err := apiHandler.ListSchemas(context.Background(), &schema_service_v2.ListSchemasRequest{AuthorId: domain_schema_2.AuthorID})
+
+		if err != nil || len(response.Schemas) != 1 || response.Schemas[0].SchemaId != domain_schema_2.SchemaID {
+			t.Errorf("Expected the schema of %s, got %+v (%v)", domain_schema_2.AuthorID, response, err)
+		}
+	})
+
+	t.Run("InvalidPageToken", func(t *testing.T) {
+		_, err := apiHandler.ListSchemas(context.Background(), &schema_service_v2.ListSchemasRequest{PageToken: "not a token"})
+
+		var domainErr *domain.Error
+		if !errors.As(err, &domainErr) || domainErr.Reason != "INVALID_PAGE_TOKEN" {
+			t.Errorf("Expected INVALID_PAGE_TOKEN, got %v", err)
+		}
+	})
+}
+
+func TestDeleteSchemaV2(t *testing.T) {
+	mockHandler := &MockSchemaHandler{}
+	apiHandler := api.SchemaServerV2{SchemaHandler: mockHandler}
+
+	_, err := apiHandler.DeleteSchema(context.Background(), &schema_service_v2.DeleteSchemaRequest{SchemaId: "NotPresentSchemaID"})
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+
+	_, err = apiHandler.DeleteSchema(context.Background(), &schema_service_v2.DeleteSchemaRequest{SchemaId: schema_id})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
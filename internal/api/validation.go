@@ -0,0 +1,301 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"server/internal/domain"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	schema_service "server/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Validate checks msg against the (alt_team.schema_service.field) rules
+// declared in the proto files and returns one violation per broken rule.
+// Field paths index into repeated fields, e.g. "tasks[1].children[0].name".
+func Validate(msg proto.Message) []domain.FieldViolation {
+	var violations []domain.FieldViolation
+	validateMessage(msg.ProtoReflect(), "", &violations)
+	return violations
+}
+
+func validateMessage(m protoreflect.Message, prefix string, violations *[]domain.FieldViolation) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		path := prefix + string(fd.Name())
+		rules := fieldRules(fd)
+
+		report := func(path string, format string, args ...any) {
+			*violations = append(*violations, domain.FieldViolation{Field: path, Description: fmt.Sprintf(format, args...)})
+		}
+
+		switch {
+		case fd.IsList():
+			list := m.Get(fd).List()
+			validateRepeated(list, rules.GetRepeated(), path, report)
+			for j := 0; j < list.Len(); j++ {
+				itemPath := fmt.Sprintf("%s[%d]", path, j)
+				if fd.Kind() == protoreflect.MessageKind {
+					validateMessage(list.Get(j).Message(), itemPath+".", violations)
+				} else {
+					validateScalar(fd, list.Get(j), rules.GetRepeated().GetItems(), itemPath, report)
+				}
+			}
+		case fd.IsMap():
+			// No map fields carry rules yet
+		case fd.Kind() == protoreflect.MessageKind:
+			if !m.Has(fd) {
+				if rules.GetRequired() {
+					report(path, "is required")
+				}
+				continue
+			}
+			if d, ok := m.Get(fd).Message().Interface().(*durationpb.Duration); ok {
+				validateDuration(d, rules.GetDuration(), path, report)
+				continue
+			}
+			validateMessage(m.Get(fd).Message(), path+".", violations)
+		default:
+			validateScalar(fd, m.Get(fd), rules, path, report)
+		}
+	}
+}
+
+func fieldRules(fd protoreflect.FieldDescriptor) *schema_service.FieldRules {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	if !ok || opts == nil {
+		return nil
+	}
+	rules, _ := proto.GetExtension(opts, schema_service.E_Field).(*schema_service.FieldRules)
+	return rules
+}
+
+type reportFunc func(path string, format string, args ...any)
+
+func validateRepeated(list protoreflect.List, rules *schema_service.RepeatedRules, path string, report reportFunc) {
+	if rules == nil {
+		return
+	}
+	n := uint64(list.Len())
+	if rules.MinItems != nil && n < *rules.MinItems {
+		report(path, "must contain at least %d items", *rules.MinItems)
+	}
+	if rules.MaxItems != nil && n > *rules.MaxItems {
+		report(path, "must contain at most %d items", *rules.MaxItems)
+	}
+	if rules.Unique {
+		seen := make(map[any]int)
+		for j := 0; j < list.Len(); j++ {
+			value := list.Get(j).Interface()
+			if first, ok := seen[value]; ok {
+				report(fmt.Sprintf("%s[%d]", path, j), "duplicates %s[%d]", path, first)
+				continue
+			}
+			seen[value] = j
+		}
+	}
+}
+
+func validateScalar(fd protoreflect.FieldDescriptor, value protoreflect.Value, rules *schema_service.FieldRules, path string, report reportFunc) {
+	if rules == nil {
+		return
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		validateString(value.String(), rules.GetString_(), path, report)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		validateInt64(value.Int(), rules.GetInt64(), path, report)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		validateInt32(int32(value.Int()), rules.GetInt32(), path, report)
+	case protoreflect.EnumKind:
+		validateEnum(fd.Enum(), value.Enum(), rules.GetEnum(), path, report)
+	}
+}
+
+var patterns sync.Map // pattern -> *regexp.Regexp
+
+func validateString(s string, rules *schema_service.StringRules, path string, report reportFunc) {
+	if rules == nil {
+		return
+	}
+	if rules.MinLen != nil && uint64(utf8.RuneCountInString(strings.TrimSpace(s))) < *rules.MinLen {
+		if *rules.MinLen == 1 {
+			report(path, "must not be empty")
+		} else {
+			report(path, "must be at least %d characters", *rules.MinLen)
+		}
+	}
+	if rules.MaxLen != nil && uint64(utf8.RuneCountInString(s)) > *rules.MaxLen {
+		report(path, "must be at most %d characters", *rules.MaxLen)
+	}
+	if rules.Pattern != "" {
+		re, ok := patterns.Load(rules.Pattern)
+		if !ok {
+			re, _ = patterns.LoadOrStore(rules.Pattern, regexp.MustCompile(rules.Pattern))
+		}
+		if !re.(*regexp.Regexp).MatchString(s) {
+			report(path, "must match pattern %q", rules.Pattern)
+		}
+	}
+}
+
+func validateInt64(v int64, rules *schema_service.Int64Rules, path string, report reportFunc) {
+	if rules == nil {
+		return
+	}
+	if rules.Gt != nil && v <= *rules.Gt {
+		report(path, "must be greater than %d", *rules.Gt)
+	}
+	if rules.Gte != nil && v < *rules.Gte {
+		report(path, "must be greater than or equal to %d", *rules.Gte)
+	}
+	if rules.Lt != nil && v >= *rules.Lt {
+		report(path, "must be less than %d", *rules.Lt)
+	}
+	if rules.Lte != nil && v > *rules.Lte {
+		report(path, "must be less than or equal to %d", *rules.Lte)
+	}
+}
+
+func validateInt32(v int32, rules *schema_service.Int32Rules, path string, report reportFunc) {
+	if rules == nil {
+		return
+	}
+	if rules.Gt != nil && v <= *rules.Gt {
+		report(path, "must be greater than %d", *rules.Gt)
+	}
+	if rules.Gte != nil && v < *rules.Gte {
+		report(path, "must be greater than or equal to %d", *rules.Gte)
+	}
+	if rules.Lt != nil && v >= *rules.Lt {
+		report(path, "must be less than %d", *rules.Lt)
+	}
+	if rules.Lte != nil && v > *rules.Lte {
+		report(path, "must be less than or equal to %d", *rules.Lte)
+	}
+}
+
+func validateDuration(d *durationpb.Duration, rules *schema_service.DurationRules, path string, report reportFunc) {
+	if err := d.CheckValid(); err != nil {
+		report(path, "must be a valid duration")
+		return
+	}
+	if rules == nil {
+		return
+	}
+	if rules.Gte != nil && d.AsDuration() < rules.Gte.AsDuration() {
+		report(path, "must be at least %s", rules.Gte.AsDuration())
+	}
+	if step := rules.MultipleOf.AsDuration(); step > 0 && d.AsDuration()%step != 0 {
+		report(path, "must be a multiple of %s", step)
+	}
+}
+
+func validateEnum(ed protoreflect.EnumDescriptor, v protoreflect.EnumNumber, rules *schema_service.EnumRules, path string, report reportFunc) {
+	if rules == nil {
+		return
+	}
+	if rules.DefinedOnly && ed.Values().ByNumber(v) == nil {
+		report(path, "must be a defined %s value", ed.Name())
+		return
+	}
+	for _, rejected := range rules.NotIn {
+		if int32(v) != rejected {
+			continue
+		}
+		if value := ed.Values().ByNumber(v); value != nil {
+			report(path, "must not be %s", value.Name())
+		} else {
+			report(path, "must not be %d", v)
+		}
+	}
+}
+
+func validationError(violations []domain.FieldViolation) error {
+	return domain.InvalidArgumentError("INVALID_REQUEST", violations, "invalid request: %s %s", violations[0].Field, violations[0].Description)
+}
+
+// Methods reporting rule violations in their response rather than failing.
+var selfValidatingMethods = map[string]bool{
+	"/alt_team.schema_service.SchemaService/ValidateSchema": true,
+	"/alt_team.schema_service.SchemaService/ImportSchemas":  true,
+}
+
+// ValidationUnaryInterceptor rejects requests breaking their field rules
+// before they reach the service implementation.
+func ValidationUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if msg, ok := req.(proto.Message); ok && !selfValidatingMethods[info.FullMethod] {
+		if violations := Validate(msg); len(violations) > 0 {
+			return nil, validationError(violations)
+		}
+	}
+	return handler(ctx, req)
+}
+
+// ValidationStreamInterceptor validates every message received on a stream.
+func ValidationStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if selfValidatingMethods[info.FullMethod] {
+		return handler(srv, ss)
+	}
+	return handler(srv, &validatingStream{ServerStream: ss})
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		if violations := Validate(msg); len(violations) > 0 {
+			return validationError(violations)
+		}
+	}
+	return nil
+}
+
+// ruleDiagnostics reports broken field rules as errors for methods
+// validating their own requests.
+func ruleDiagnostics(violations []domain.FieldViolation, tasks []*schema_service.Task) []domain.Diagnostic {
+	var diagnostics []domain.Diagnostic
+	for _, v := range violations {
+		diagnostics = append(diagnostics, domain.Diagnostic{
+			Severity: domain.SeverityError,
+			Code:     "INVALID_FIELD",
+			Field:    v.Field,
+			TaskPath: taskPathOf(tasks, v.Field),
+			Message:  v.Field + " " + v.Description,
+		})
+	}
+	return diagnostics
+}
+
+var taskIndexPattern = regexp.MustCompile(`(?:^tasks|\.children)\[(\d+)\]`)
+
+// taskPathOf returns the ids of the tasks along a field path such as
+// "tasks[1].children[0].name", or nil when the path is not inside a task.
+func taskPathOf(tasks []*schema_service.Task, field string) []int64 {
+	var path []int64
+	for _, match := range taskIndexPattern.FindAllStringSubmatch(field, -1) {
+		i, err := strconv.Atoi(match[1])
+		if err != nil || i >= len(tasks) {
+			return path
+		}
+		path = append(path, tasks[i].Id)
+		tasks = tasks[i].Children
+	}
+	return path
+}
@@ -0,0 +1,166 @@
+package api_test
+
+import (
+	"context"
+	"reflect"
+	"server/internal/api"
+	"server/internal/domain"
+	schema_service "server/proto"
+	schema_service_v2 "server/proto/v2"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func validTask(id int64) *schema_service.Task {
+	return &schema_service.Task{
+		Id:        id,
+		Level:     1,
+		Name:      "Task",
+		Status:    schema_service.TaskStatus_TASK_STATUS_NOT_STARTED,
+		TimeLimit: 60,
+		Comment:   wrapperspb.String(""),
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("Valid request", func(t *testing.T) {
+		request := &schema_service.CreateSchemaRequest{
+			AuthorId:   "authorID",
+			SchemaName: "schemaName",
+			Tasks:      []*schema_service.Task{&task1, &task2},
+		}
+
+		if violations := api.Validate(request); len(violations) != 0 {
+			t.Errorf("Expected no violations, got %+v", violations)
+		}
+	})
+
+	t.Run("Empty fields", func(t *testing.T) {
+		request := &schema_service.CreateSchemaRequest{AuthorId: " ", SchemaName: ""}
+
+		expected := []domain.FieldViolation{
+			{Field: "author_id", Description: "must not be empty"},
+			{Field: "schema_name", Description: "must not be empty"},
+		}
+		if violations := api.Validate(request); !reflect.DeepEqual(violations, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, violations)
+		}
+	})
+
+	t.Run("Nested task path", func(t *testing.T) {
+		child := validTask(3)
+		child.TimeLimit = -5
+		child.Status = schema_service.TaskStatus_TASK_STATUS_UNSPECIFIED
+		child.BlockedBy = []int64{1, 1}
+		parent := validTask(2)
+		parent.Children = []*schema_service.Task{child}
+
+		request := &schema_service.CreateSchemaRequest{
+			AuthorId:   "authorID",
+			SchemaName: "schemaName",
+			Tasks:      []*schema_service.Task{validTask(1), parent},
+		}
+
+		expected := []domain.FieldViolation{
+			{Field: "tasks[1].children[0].status", Description: "must not be TASK_STATUS_UNSPECIFIED"},
+			{Field: "tasks[1].children[0].blocked_by[1]", Description: "duplicates tasks[1].children[0].blocked_by[0]"},
+			{Field: "tasks[1].children[0].time_limit", Description: "must be greater than or equal to 0"},
+		}
+		if violations := api.Validate(request); !reflect.DeepEqual(violations, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, violations)
+		}
+	})
+
+	t.Run("Undefined enum value", func(t *testing.T) {
+		task := validTask(1)
+		task.Status = schema_service.TaskStatus(42)
+
+		violations := api.Validate(task)
+
+		if len(violations) != 1 || violations[0].Field != "status" {
+			t.Errorf("Expected a status violation, got %+v", violations)
+		}
+	})
+
+	t.Run("Repeated item rules", func(t *testing.T) {
+		request := &schema_service.BatchGetSchemasRequest{SchemaIds: []string{"id", ""}}
+
+		expected := []domain.FieldViolation{
+			{Field: "schema_ids[1]", Description: "must not be empty"},
+		}
+		if violations := api.Validate(request); !reflect.DeepEqual(violations, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, violations)
+		}
+	})
+
+	t.Run("Duration rules", func(t *testing.T) {
+		request := &schema_service_v2.CreateSchemaRequest{
+			AuthorId:   "authorID",
+			SchemaName: "schemaName",
+			Tasks: []*schema_service_v2.Task{
+				{Id: 1, Name: "Task", Status: schema_service_v2.TaskStatus_TASK_STATUS_DONE, TimeLimit: durationpb.New(90 * time.Second)},
+				{Id: 2, Name: "Task", Status: schema_service_v2.TaskStatus_TASK_STATUS_DONE, TimeLimit: durationpb.New(-time.Minute), Labels: []string{"a", "a"}},
+			},
+		}
+
+		expected := []domain.FieldViolation{
+			{Field: "tasks[0].time_limit", Description: "must be a multiple of 1m0s"},
+			{Field: "tasks[1].time_limit", Description: "must be at least 0s"},
+			{Field: "tasks[1].labels[1]", Description: "duplicates tasks[1].labels[0]"},
+		}
+		if violations := api.Validate(request); !reflect.DeepEqual(violations, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, violations)
+		}
+	})
+}
+
+func TestValidationUnaryInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/alt_team.schema_service.SchemaService/CreateSchema"}
+	called := false
+	handler := func(ctx context.Context, req any) (any, error) {
+		called = true
+		return &schema_service.CreateSchemaResponse{}, nil
+	}
+
+	t.Run("Rejects invalid request", func(t *testing.T) {
+		request := &schema_service.CreateSchemaRequest{AuthorId: "authorID"}
+		_, err := api.ValidationUnaryInterceptor(context.Background(), request, info, handler)
+
+		if called {
+			t.Errorf("Handler should not be called")
+		}
+
+		st := api.StatusFromError(err)
+		if st.Code() != codes.InvalidArgument {
+			t.Fatalf("Expected InvalidArgument, got %v", err)
+		}
+
+		var fields []string
+		for _, detail := range st.Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+				for _, violation := range badRequest.FieldViolations {
+					fields = append(fields, violation.Field)
+				}
+			}
+		}
+		if !reflect.DeepEqual(fields, []string{"schema_name"}) {
+			t.Errorf("Expected schema_name violation, got %v", fields)
+		}
+	})
+
+	t.Run("Forwards valid request", func(t *testing.T) {
+		request := proto.Clone(&schema_service.CreateSchemaRequest{AuthorId: "authorID", SchemaName: "schemaName"})
+		_, err := api.ValidationUnaryInterceptor(context.Background(), request, info, handler)
+
+		if err != nil || !called {
+			t.Errorf("Expected handler to be called, got %v", err)
+		}
+	})
+}
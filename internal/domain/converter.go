@@ -15,55 +15,388 @@ import (
 
 func SchemaToGRPC(s *Schema) *schema_service.Schema {
 	return &schema_service.Schema{
-		SchemaId:   s.SchemaID,
-		AuthorId:   s.AuthorID,
-		SchemaName: s.SchemaName,
-		CreatedAt:  convertTimestampFromTime(s.CreatedAt),
-		UpdatedAt:  convertTimestampFromTime(s.UpdatedAt),
-		DeletedAt:  convertTimestampFromTime(s.DeletedAt),
-		Tasks:      TasksToGRPC(s.Tasks),
+		SchemaId:       s.SchemaID,
+		AuthorId:       s.AuthorID,
+		SchemaName:     s.SchemaName,
+		CreatedAt:      convertSchemaTimestampFromTime(s.CreatedAt),
+		UpdatedAt:      convertSchemaTimestampFromTime(s.UpdatedAt),
+		DeletedAt:      convertSchemaTimestampFromTime(s.DeletedAt),
+		Tasks:          TasksToGRPC(s.Tasks),
+		Revision:       s.Revision,
+		ParentSchemaId: s.ParentID,
+		Slug:           s.Slug,
+		LatestVersion:  s.LatestVersion(),
+		State:          SchemaStateToGRPC(s.State),
+		StateHistory:   stateChangesToGRPC(s.StateHistory),
+		Reviews:        reviewsToGRPC(s.Reviews),
+		DraftChanged:   s.DraftChanged(),
 	}
 }
 
+func reviewsToGRPC(reviews []Review) []*schema_service.Review {
+	var grpcReviews []*schema_service.Review
+	for _, r := range reviews {
+		grpcReview := &schema_service.Review{
+			Number:      r.Number,
+			RequestedBy: r.RequestedBy,
+			RequestedAt: convertTimestampFromTime(r.RequestedAt),
+			Comment:     r.Comment,
+			Policy:      &schema_service.ReviewPolicy{MinApprovals: int32(r.Policy.MinApprovals), RequiredRoles: r.Policy.RequiredRoles},
+			Status:      reviewStatusToGRPC(r.Status),
+		}
+		for _, record := range r.Records {
+			grpcReview.Records = append(grpcReview.Records, &schema_service.ReviewRecord{
+				Reviewer: record.Reviewer,
+				Roles:    record.Roles,
+				Decision: reviewDecisionToGRPC(record.Decision),
+				Comment:  record.Comment,
+				Time:     convertTimestampFromTime(record.Time),
+			})
+		}
+		grpcReviews = append(grpcReviews, grpcReview)
+	}
+	return grpcReviews
+}
+
+func FieldChangesToGRPC(changes []FieldChange) []*schema_service.FieldChange {
+	var grpcChanges []*schema_service.FieldChange
+	for _, c := range changes {
+		grpcChanges = append(grpcChanges, &schema_service.FieldChange{Field: c.Field, Before: c.Before, After: c.After})
+	}
+	return grpcChanges
+}
+
+func TaskChangesToGRPC(changes []TaskChange) []*schema_service.TaskChange {
+	var grpcChanges []*schema_service.TaskChange
+	for _, c := range changes {
+		grpcChanges = append(grpcChanges, &schema_service.TaskChange{
+			Kind:   taskChangeKindToGRPC(c.Kind),
+			TaskId: c.TaskID,
+			Name:   c.Name,
+			Fields: FieldChangesToGRPC(c.Fields),
+		})
+	}
+	return grpcChanges
+}
+
+func taskChangeKindToGRPC(kind TaskChangeKind) schema_service.TaskChangeKind {
+	switch kind {
+	case ChangeAdded:
+		return schema_service.TaskChangeKind_TASK_CHANGE_KIND_ADDED
+	case ChangeRemoved:
+		return schema_service.TaskChangeKind_TASK_CHANGE_KIND_REMOVED
+	case ChangeMoved:
+		return schema_service.TaskChangeKind_TASK_CHANGE_KIND_MOVED
+	case ChangeModified:
+		return schema_service.TaskChangeKind_TASK_CHANGE_KIND_MODIFIED
+	default:
+		return schema_service.TaskChangeKind_TASK_CHANGE_KIND_UNSPECIFIED
+	}
+}
+
+func reviewStatusToGRPC(status ReviewStatus) schema_service.ReviewStatus {
+	switch status {
+	case ReviewOpen:
+		return schema_service.ReviewStatus_REVIEW_STATUS_OPEN
+	case ReviewApproved:
+		return schema_service.ReviewStatus_REVIEW_STATUS_APPROVED
+	case ReviewRejected:
+		return schema_service.ReviewStatus_REVIEW_STATUS_REJECTED
+	case ReviewWithdrawn:
+		return schema_service.ReviewStatus_REVIEW_STATUS_WITHDRAWN
+	default:
+		return schema_service.ReviewStatus_REVIEW_STATUS_UNSPECIFIED
+	}
+}
+
+func reviewDecisionToGRPC(decision ReviewDecision) schema_service.ReviewDecision {
+	switch decision {
+	case DecisionApprove:
+		return schema_service.ReviewDecision_REVIEW_DECISION_APPROVE
+	case DecisionReject:
+		return schema_service.ReviewDecision_REVIEW_DECISION_REJECT
+	default:
+		return schema_service.ReviewDecision_REVIEW_DECISION_UNSPECIFIED
+	}
+}
+
+func stateChangesToGRPC(changes []StateChange) []*schema_service.StateChange {
+	var grpcChanges []*schema_service.StateChange
+	for _, c := range changes {
+		grpcChanges = append(grpcChanges, &schema_service.StateChange{
+			From:    SchemaStateToGRPC(c.From),
+			To:      SchemaStateToGRPC(c.To),
+			Actor:   c.Actor,
+			Time:    convertTimestampFromTime(c.Time),
+			Comment: c.Comment,
+		})
+	}
+	return grpcChanges
+}
+
+func SchemaStateToGRPC(state SchemaState) schema_service.SchemaState {
+	switch state {
+	case StateDraft:
+		return schema_service.SchemaState_SCHEMA_STATE_DRAFT
+	case StateInReview:
+		return schema_service.SchemaState_SCHEMA_STATE_IN_REVIEW
+	case StateApproved:
+		return schema_service.SchemaState_SCHEMA_STATE_APPROVED
+	case StatePublished:
+		return schema_service.SchemaState_SCHEMA_STATE_PUBLISHED
+	case StateDeprecated:
+		return schema_service.SchemaState_SCHEMA_STATE_DEPRECATED
+	case StateArchived:
+		return schema_service.SchemaState_SCHEMA_STATE_ARCHIVED
+	default:
+		return schema_service.SchemaState_SCHEMA_STATE_UNSPECIFIED
+	}
+}
+
+func SchemaVersionToGRPC(schemaID string, v *SchemaVersion) *schema_service.SchemaVersion {
+	return &schema_service.SchemaVersion{
+		SchemaId:    schemaID,
+		Version:     v.Version,
+		SchemaName:  v.SchemaName,
+		Tasks:       TasksToGRPC(v.Tasks),
+		PublishedAt: convertTimestampFromTime(v.PublishedAt),
+		Revision:    v.Revision,
+	}
+}
+
+// TasksToGRPC converts the root tasks of a tree. Levels are emitted from the
+// depth of each task rather than taken from the stored value.
 func TasksToGRPC(tasks []Task) []*schema_service.Task {
+	return tasksToGRPC(tasks, 1)
+}
+
+func tasksToGRPC(tasks []Task, level int) []*schema_service.Task {
 	var grpcTasks []*schema_service.Task
 	for _, t := range tasks {
-		grpcTask := TaskToGRPC(&t)
+		grpcTask := taskToGRPC(&t, level)
 		grpcTasks = append(grpcTasks, grpcTask)
 	}
 	return grpcTasks
 }
 
+// TaskToGRPC converts a root task.
 func TaskToGRPC(t *Task) *schema_service.Task {
+	return taskToGRPC(t, 1)
+}
+
+func taskToGRPC(t *Task, level int) *schema_service.Task {
 	return &schema_service.Task{
 		Id:          int64(t.ID),
-		Level:       int32(t.Level),
+		Level:       int32(level),
 		Name:        t.Name,
 		Status:      convertTaskStatusToGRPC(t.Status),
 		BlockedBy:   t.BlockedBy,
 		Responsible: t.Responsible,
 		TimeLimit:   t.TimeLimit,
-		Children:    TasksToGRPC(t.Children),
+		Children:    tasksToGRPC(t.Children, level+1),
 		Comment:     wrapperspb.String(t.Comment.Value),
 	}
 }
 
+func ChangeSetToGRPC(c *ChangeSet) *schema_service.GetChangesSinceResponse {
+	response := &schema_service.GetChangesSinceResponse{
+		Revision: c.Revision,
+		HasMore:  c.HasMore,
+	}
+	for _, schema := range c.Schemas {
+		response.Schemas = append(response.Schemas, SchemaToGRPC(&schema))
+	}
+	for _, t := range c.Tombstones {
+		response.Tombstones = append(response.Tombstones, &schema_service.Tombstone{
+			SchemaId:  t.SchemaID,
+			Revision:  t.Revision,
+			DeletedAt: convertTimestampFromTime(t.DeletedAt),
+		})
+	}
+	return response
+}
+
+func SchemaEventToGRPC(e *SchemaEvent) *schema_service.SchemaEvent {
+	return &schema_service.SchemaEvent{
+		Revision:  e.Revision,
+		Type:      convertEventTypeToGRPC(e.Type),
+		SchemaId:  e.SchemaID,
+		Schema:    SchemaToGRPC(&e.Schema),
+		Timestamp: convertTimestampFromTime(e.Timestamp),
+	}
+}
+
+func convertEventTypeToGRPC(eventType EventType) schema_service.SchemaEventType {
+	switch eventType {
+	case EventCreated:
+		return schema_service.SchemaEventType_SCHEMA_EVENT_TYPE_CREATED
+	case EventUpdated:
+		return schema_service.SchemaEventType_SCHEMA_EVENT_TYPE_UPDATED
+	case EventDeleted:
+		return schema_service.SchemaEventType_SCHEMA_EVENT_TYPE_DELETED
+	default:
+		return schema_service.SchemaEventType_SCHEMA_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func SearchResultToGRPC(r *SearchResult) *schema_service.SearchResult {
+	var matches []*schema_service.SearchMatch
+	for _, m := range r.Matches {
+		matches = append(matches, &schema_service.SearchMatch{
+			Field:    convertSearchFieldToGRPC(m.Field),
+			TaskPath: m.TaskPath,
+			Snippet:  m.Snippet,
+		})
+	}
+
+	return &schema_service.SearchResult{
+		Schema:  SchemaToGRPC(&r.Schema),
+		Score:   r.Score,
+		Matches: matches,
+	}
+}
+
+func convertSearchFieldToGRPC(field SearchField) schema_service.SearchField {
+	switch field {
+	case SearchFieldSchemaName:
+		return schema_service.SearchField_SEARCH_FIELD_SCHEMA_NAME
+	case SearchFieldTaskName:
+		return schema_service.SearchField_SEARCH_FIELD_TASK_NAME
+	case SearchFieldTaskComment:
+		return schema_service.SearchField_SEARCH_FIELD_TASK_COMMENT
+	default:
+		return schema_service.SearchField_SEARCH_FIELD_UNSPECIFIED
+	}
+}
+
+func DiagnosticsToGRPC(diagnostics []Diagnostic) []*schema_service.Diagnostic {
+	var grpcDiagnostics []*schema_service.Diagnostic
+	for _, d := range diagnostics {
+		grpcDiagnostics = append(grpcDiagnostics, &schema_service.Diagnostic{
+			Severity: convertSeverityToGRPC(d.Severity),
+			Code:     d.Code,
+			Field:    d.Field,
+			TaskPath: d.TaskPath,
+			Message:  d.Message,
+		})
+	}
+	return grpcDiagnostics
+}
+
+func convertSeverityToGRPC(severity Severity) schema_service.DiagnosticSeverity {
+	switch severity {
+	case SeverityError:
+		return schema_service.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_ERROR
+	case SeverityWarning:
+		return schema_service.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_WARNING
+	case SeverityInfo:
+		return schema_service.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_INFO
+	default:
+		return schema_service.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_UNSPECIFIED
+	}
+}
+
+func SchemaStateFromGRPC(state schema_service.SchemaState) SchemaState {
+	switch state {
+	case schema_service.SchemaState_SCHEMA_STATE_DRAFT:
+		return StateDraft
+	case schema_service.SchemaState_SCHEMA_STATE_IN_REVIEW:
+		return StateInReview
+	case schema_service.SchemaState_SCHEMA_STATE_APPROVED:
+		return StateApproved
+	case schema_service.SchemaState_SCHEMA_STATE_PUBLISHED:
+		return StatePublished
+	case schema_service.SchemaState_SCHEMA_STATE_DEPRECATED:
+		return StateDeprecated
+	case schema_service.SchemaState_SCHEMA_STATE_ARCHIVED:
+		return StateArchived
+	default:
+		return ""
+	}
+}
+
+func SchemaStatesFromGRPC(states []schema_service.SchemaState) []SchemaState {
+	var domainStates []SchemaState
+	for _, state := range states {
+		domainStates = append(domainStates, SchemaStateFromGRPC(state))
+	}
+	return domainStates
+}
+
+func ConflictPolicyFromGRPC(policy schema_service.ImportConflictPolicy) ConflictPolicy {
+	switch policy {
+	case schema_service.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_SKIP:
+		return ConflictSkip
+	case schema_service.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_OVERWRITE:
+		return ConflictOverwrite
+	case schema_service.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_RENAME:
+		return ConflictRename
+	default:
+		return ConflictFail
+	}
+}
+
+// ImportResultToGRPC converts r except for its error, which the API layer
+// translates into a status.
+func ImportResultToGRPC(r *ImportResult) *schema_service.ImportItemResult {
+	return &schema_service.ImportItemResult{
+		Index:       int32(r.Index),
+		Outcome:     convertImportOutcomeToGRPC(r.Outcome),
+		SchemaId:    r.SchemaID,
+		SchemaName:  r.SchemaName,
+		Diagnostics: DiagnosticsToGRPC(r.Diagnostics),
+	}
+}
+
+func convertImportOutcomeToGRPC(outcome ImportOutcome) schema_service.ImportOutcome {
+	switch outcome {
+	case ImportCreated:
+		return schema_service.ImportOutcome_IMPORT_OUTCOME_CREATED
+	case ImportRenamed:
+		return schema_service.ImportOutcome_IMPORT_OUTCOME_RENAMED
+	case ImportOverwritten:
+		return schema_service.ImportOutcome_IMPORT_OUTCOME_OVERWRITTEN
+	case ImportSkipped:
+		return schema_service.ImportOutcome_IMPORT_OUTCOME_SKIPPED
+	case ImportInvalid:
+		return schema_service.ImportOutcome_IMPORT_OUTCOME_INVALID
+	case ImportFailed:
+		return schema_service.ImportOutcome_IMPORT_OUTCOME_FAILED
+	default:
+		return schema_service.ImportOutcome_IMPORT_OUTCOME_UNSPECIFIED
+	}
+}
+
+// convertTimestampFromTime leaves zero times unset.
 func convertTimestampFromTime(t time.Time) *timestamp.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
 	return &timestamp.Timestamp{
-		Seconds: int64(t.Second()),
+		Seconds: t.Unix(),
 		Nanos:   int32(t.Nanosecond()),
 	}
 }
 
-func convertTaskStatusToGRPC(status string) schema_service.TaskStatus {
+// convertSchemaTimestampFromTime converts the times of a v1 schema, which
+// always carried them, zero times as the empty timestamp (e.g. deleted_at of
+// live schemas).
+func convertSchemaTimestampFromTime(t time.Time) *timestamp.Timestamp {
+	if t.IsZero() {
+		return &timestamp.Timestamp{}
+	}
+	return convertTimestampFromTime(t)
+}
+
+func convertTaskStatusToGRPC(status TaskStatus) schema_service.TaskStatus {
 	switch status {
-	case "NOT_STARTED":
+	case TaskNotStarted:
 		return schema_service.TaskStatus_TASK_STATUS_NOT_STARTED
-	case "IN_PROGRESS":
+	case TaskInProgress:
 		return schema_service.TaskStatus_TASK_STATUS_IN_PROGRESS
-	case "BLOCKED":
+	case TaskBlocked:
 		return schema_service.TaskStatus_TASK_STATUS_BLOCKED
-	case "DONE":
+	case TaskDone:
 		return schema_service.TaskStatus_TASK_STATUS_DONE
 	default:
 		return schema_service.TaskStatus_TASK_STATUS_UNSPECIFIED
@@ -95,22 +428,22 @@ func TaskFromGRPC(t *schema_service.Task) Task {
 		Comment: struct {
 			Value string "json:\"value\""
 		}{
-			Value: t.Comment.Value,
+			Value: t.GetComment().GetValue(),
 		},
 	}
 }
 
-func convertTaskStatusFromGRPC(status *schema_service.TaskStatus) string {
+func convertTaskStatusFromGRPC(status *schema_service.TaskStatus) TaskStatus {
 	switch *status {
 	case schema_service.TaskStatus_TASK_STATUS_NOT_STARTED:
-		return "NOT_STARTED"
+		return TaskNotStarted
 	case schema_service.TaskStatus_TASK_STATUS_IN_PROGRESS:
-		return "IN_PROGRESS"
+		return TaskInProgress
 	case schema_service.TaskStatus_TASK_STATUS_BLOCKED:
-		return "BLOCKED"
+		return TaskBlocked
 	case schema_service.TaskStatus_TASK_STATUS_DONE:
-		return "DONE"
+		return TaskDone
 	default:
-		return "UNSPECIFIED"
+		return ""
 	}
 }
@@ -0,0 +1,159 @@
+// internal/domain/converter_v2.go
+
+package domain
+
+import (
+	"time"
+
+	schema_service_v2 "server/proto/v2"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Messages of API version 2 are translated to and from the same domain types
+// as version 1, so both versions read and write the same schemas. Fields that
+// only exist in one version are left untouched by the other.
+
+// To gRPC v2
+
+func SchemaToV2(s *Schema) *schema_service_v2.Schema {
+	return &schema_service_v2.Schema{
+		SchemaId:   s.SchemaID,
+		AuthorId:   s.AuthorID,
+		SchemaName: s.SchemaName,
+		Tasks:      TasksToV2(s.Tasks),
+		Metadata: &schema_service_v2.SchemaMetadata{
+			Revision:       s.Revision,
+			CreateTime:     convertTimestampToV2(s.CreatedAt),
+			UpdateTime:     convertTimestampToV2(s.UpdatedAt),
+			ParentSchemaId: s.ParentID,
+		},
+		Slug: s.Slug,
+	}
+}
+
+// TasksToV2 converts the root tasks of a tree, with levels from their depth
+// as in TasksToGRPC.
+func TasksToV2(tasks []Task) []*schema_service_v2.Task {
+	return tasksToV2(tasks, 1)
+}
+
+func tasksToV2(tasks []Task, level int) []*schema_service_v2.Task {
+	var v2Tasks []*schema_service_v2.Task
+	for _, t := range tasks {
+		v2Tasks = append(v2Tasks, taskToV2(&t, level))
+	}
+	return v2Tasks
+}
+
+// TaskToV2 converts a root task.
+func TaskToV2(t *Task) *schema_service_v2.Task {
+	return taskToV2(t, 1)
+}
+
+func taskToV2(t *Task, level int) *schema_service_v2.Task {
+	task := &schema_service_v2.Task{
+		Id:          int64(t.ID),
+		Level:       int32(level),
+		Name:        t.Name,
+		Status:      convertTaskStatusToV2(t.Status),
+		BlockedBy:   t.BlockedBy,
+		Responsible: t.Responsible,
+		Children:    tasksToV2(t.Children, level+1),
+		Comment:     t.Comment.Value,
+		Description: t.Description,
+		Labels:      t.Labels,
+	}
+	if t.TimeLimit != 0 {
+		task.TimeLimit = durationpb.New(time.Duration(t.TimeLimit) * time.Minute)
+	}
+	return task
+}
+
+func convertTaskStatusToV2(status TaskStatus) schema_service_v2.TaskStatus {
+	switch status {
+	case TaskNotStarted:
+		return schema_service_v2.TaskStatus_TASK_STATUS_NOT_STARTED
+	case TaskInProgress:
+		return schema_service_v2.TaskStatus_TASK_STATUS_IN_PROGRESS
+	case TaskBlocked:
+		return schema_service_v2.TaskStatus_TASK_STATUS_BLOCKED
+	case TaskDone:
+		return schema_service_v2.TaskStatus_TASK_STATUS_DONE
+	default:
+		return schema_service_v2.TaskStatus_TASK_STATUS_UNSPECIFIED
+	}
+}
+
+func convertTimestampToV2(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// From gRPC v2
+
+func TasksFromV2(v2Tasks []*schema_service_v2.Task) []Task {
+	var tasks []Task
+	for _, t := range v2Tasks {
+		tasks = append(tasks, TaskFromV2(t))
+	}
+	return tasks
+}
+
+// TaskFromV2 converts t, truncating its time limit to whole minutes.
+func TaskFromV2(t *schema_service_v2.Task) Task {
+	task := Task{
+		ID:          int(t.Id),
+		Level:       int(t.Level),
+		Name:        t.Name,
+		Status:      convertTaskStatusFromV2(t.Status),
+		BlockedBy:   t.BlockedBy,
+		Responsible: t.Responsible,
+		TimeLimit:   int64(t.TimeLimit.AsDuration() / time.Minute),
+		Children:    TasksFromV2(t.Children),
+		Description: t.Description,
+		Labels:      t.Labels,
+	}
+	task.Comment.Value = t.Comment
+	return task
+}
+
+func convertTaskStatusFromV2(status schema_service_v2.TaskStatus) TaskStatus {
+	switch status {
+	case schema_service_v2.TaskStatus_TASK_STATUS_NOT_STARTED:
+		return TaskNotStarted
+	case schema_service_v2.TaskStatus_TASK_STATUS_IN_PROGRESS:
+		return TaskInProgress
+	case schema_service_v2.TaskStatus_TASK_STATUS_BLOCKED:
+		return TaskBlocked
+	case schema_service_v2.TaskStatus_TASK_STATUS_DONE:
+		return TaskDone
+	default:
+		return ""
+	}
+}
+
+// KeepV2Fields carries the description and labels of the tasks of previous
+// over to the tasks of tasks with the same id that have none, for drafts
+// replaced through version 1. The tasks are modified in place.
+func KeepV2Fields(tasks []Task, previous []Task) {
+	byID := NewTaskGraph(previous).byID
+	var keep func(tasks []Task)
+	keep = func(tasks []Task) {
+		for i := range tasks {
+			if ref, ok := byID[int64(tasks[i].ID)]; ok {
+				if tasks[i].Description == "" {
+					tasks[i].Description = ref.task.Description
+				}
+				if len(tasks[i].Labels) == 0 && len(ref.task.Labels) > 0 {
+					tasks[i].Labels = append([]string{}, ref.task.Labels...)
+				}
+			}
+			keep(tasks[i].Children)
+		}
+	}
+	keep(tasks)
+}
@@ -0,0 +1,223 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type TaskChangeKind string
+
+const (
+	ChangeAdded    TaskChangeKind = "ADDED"
+	ChangeRemoved  TaskChangeKind = "REMOVED"
+	ChangeMoved    TaskChangeKind = "MOVED" // to another parent or order among its siblings
+	ChangeModified TaskChangeKind = "MODIFIED"
+)
+
+// FieldChange is a field with its value before and after a change, in JSON.
+// A value is empty when there is no task on that side.
+type FieldChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+// TaskChange is a change of one task, matched by id. Name is the name after
+// the change, or before it for removed tasks.
+type TaskChange struct {
+	Kind   TaskChangeKind
+	TaskID int64
+	Name   string
+	Fields []FieldChange
+}
+
+// SchemaDiff lists the changes from one schema to another: those of the
+// schema itself, then those of its tasks in the order of the new tree,
+// followed by the removed tasks. A task both moved and modified has a change
+// of each kind.
+type SchemaDiff struct {
+	SchemaChanges []FieldChange
+	TaskChanges   []TaskChange
+}
+
+// DiffSchemas compares the drafts of from and to, matching their tasks by
+// id. Tasks reusing an id cannot be matched and fail the comparison.
+func DiffSchemas(from Schema, to Schema) (SchemaDiff, error) {
+	var diff SchemaDiff
+	fromGraph, toGraph := NewTaskGraph(from.Tasks), NewTaskGraph(to.Tasks)
+	for _, side := range []struct {
+		schema Schema
+		graph  *TaskGraph
+	}{{from, fromGraph}, {to, toGraph}} {
+		if len(side.graph.byID) != len(side.graph.nodes) {
+			return SchemaDiff{}, NewError(ErrFailedPrecondition, "AMBIGUOUS_TASK_IDS", map[string]string{"schema_id": side.schema.SchemaID},
+				"tasks of schema with id=<%s> reuse ids and cannot be compared", side.schema.SchemaID)
+		}
+	}
+
+	if from.SchemaName != to.SchemaName {
+		diff.SchemaChanges = append(diff.SchemaChanges, FieldChange{Field: "schema_name", Before: jsonValue(from.SchemaName), After: jsonValue(to.SchemaName)})
+	}
+
+	fromRanks, toRanks := siblingRanks(fromGraph, toGraph), siblingRanks(toGraph, fromGraph)
+	for _, ref := range toGraph.nodes {
+		id := int64(ref.task.ID)
+		before, ok := fromGraph.byID[id]
+		if !ok {
+			diff.TaskChanges = append(diff.TaskChanges, TaskChange{Kind: ChangeAdded, TaskID: id, Name: ref.task.Name, Fields: taskFields(taskRef{}, ref)})
+			continue
+		}
+		if parentOf(before) != parentOf(ref) || fromRanks[id] != toRanks[id] {
+			moved := TaskChange{Kind: ChangeMoved, TaskID: id, Name: ref.task.Name}
+			if parentOf(before) != parentOf(ref) {
+				moved.Fields = append(moved.Fields, FieldChange{Field: "parent", Before: parentValue(before), After: parentValue(ref)})
+			}
+			moved.Fields = append(moved.Fields, FieldChange{Field: "position", Before: jsonValue(before.index + 1), After: jsonValue(ref.index + 1)})
+			diff.TaskChanges = append(diff.TaskChanges, moved)
+		}
+		if fields := taskFields(before, ref); len(fields) > 0 {
+			diff.TaskChanges = append(diff.TaskChanges, TaskChange{Kind: ChangeModified, TaskID: id, Name: ref.task.Name, Fields: fields})
+		}
+	}
+	for _, ref := range fromGraph.nodes {
+		if _, ok := toGraph.byID[int64(ref.task.ID)]; !ok {
+			diff.TaskChanges = append(diff.TaskChanges, TaskChange{Kind: ChangeRemoved, TaskID: int64(ref.task.ID), Name: ref.task.Name, Fields: taskFields(ref, taskRef{})})
+		}
+	}
+	return diff, nil
+}
+
+type parentKey struct {
+	id   int64
+	root bool
+}
+
+func parentOf(ref taskRef) parentKey {
+	if ref.parent == nil {
+		return parentKey{root: true}
+	}
+	return parentKey{id: int64(ref.parent.ID)}
+}
+
+func parentValue(ref taskRef) string {
+	if ref.parent == nil {
+		return "null"
+	}
+	return jsonValue(ref.parent.ID)
+}
+
+// siblingRanks ranks every task of g among its siblings kept under the same
+// parent in other, so that tasks added or removed around it do not count as
+// moves.
+func siblingRanks(g *TaskGraph, other *TaskGraph) map[int64]int {
+	ranks := make(map[int64]int)
+	next := make(map[parentKey]int)
+	for _, ref := range g.nodes {
+		id := int64(ref.task.ID)
+		if otherRef, ok := other.byID[id]; ok && parentOf(otherRef) == parentOf(ref) {
+			ranks[id] = next[parentOf(ref)]
+			next[parentOf(ref)]++
+		}
+	}
+	return ranks
+}
+
+// taskFields lists the fields differing between the tasks, either of which
+// may be missing. Added and removed child tasks also get their parent.
+func taskFields(before taskRef, after taskRef) []FieldChange {
+	beforeValues, afterValues := fieldValues(before.task), fieldValues(after.task)
+	var fields []FieldChange
+	if before.task == nil && after.parent != nil {
+		fields = append(fields, FieldChange{Field: "parent", After: parentValue(after)})
+	}
+	if after.task == nil && before.parent != nil {
+		fields = append(fields, FieldChange{Field: "parent", Before: parentValue(before)})
+	}
+	for i, field := range diffFields {
+		if beforeValues[i] != afterValues[i] {
+			fields = append(fields, FieldChange{Field: field, Before: beforeValues[i], After: afterValues[i]})
+		}
+	}
+	return fields
+}
+
+var diffFields = []string{"name", "status", "blocked_by", "responsible", "time_limit", "comment", "description", "labels"}
+
+// fieldValues returns the diffFields of task in JSON, omitting empty
+// values, or nothing for a missing task.
+func fieldValues(task *Task) []string {
+	values := make([]string, len(diffFields))
+	if task == nil {
+		return values
+	}
+	for i, value := range []any{task.Name, string(task.Status), task.BlockedBy, task.Responsible, task.TimeLimit, task.Comment.Value, task.Description, task.Labels} {
+		switch v := value.(type) {
+		case string:
+			if v == "" {
+				continue
+			}
+		case int64:
+			if v == 0 {
+				continue
+			}
+		case []int64:
+			if len(v) == 0 {
+				continue
+			}
+		case []string:
+			if len(v) == 0 {
+				continue
+			}
+		}
+		values[i] = jsonValue(value)
+	}
+	return values
+}
+
+func jsonValue(value any) string {
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
+// Text renders the diff for people, one line per change followed by its
+// fields, e.g.
+//
+//	~ task 2 "Check vitals" modified
+//	    status: "NOT_STARTED" -> "DONE"
+func (d SchemaDiff) Text() string {
+	if len(d.SchemaChanges) == 0 && len(d.TaskChanges) == 0 {
+		return "no changes\n"
+	}
+
+	var b strings.Builder
+	writeFields := func(kind TaskChangeKind, fields []FieldChange) {
+		for _, f := range fields {
+			switch kind {
+			case ChangeAdded:
+				fmt.Fprintf(&b, "    %s: %s\n", f.Field, f.After)
+			case ChangeRemoved:
+				fmt.Fprintf(&b, "    %s: %s\n", f.Field, f.Before)
+			default:
+				fmt.Fprintf(&b, "    %s: %s -> %s\n", f.Field, orNone(f.Before), orNone(f.After))
+			}
+		}
+	}
+	if len(d.SchemaChanges) > 0 {
+		b.WriteString("~ schema modified\n")
+		writeFields(ChangeModified, d.SchemaChanges)
+	}
+	symbols := map[TaskChangeKind]string{ChangeAdded: "+", ChangeRemoved: "-", ChangeMoved: ">", ChangeModified: "~"}
+	for _, c := range d.TaskChanges {
+		fmt.Fprintf(&b, "%s task %d %q %s\n", symbols[c.Kind], c.TaskID, c.Name, strings.ToLower(string(c.Kind)))
+		writeFields(c.Kind, c.Fields)
+	}
+	return b.String()
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
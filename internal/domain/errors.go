@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error kinds. Every error produced by the handler and storage layers wraps
+// one of these, so callers can classify it with errors.Is.
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrResourceExhausted  = errors.New("resource exhausted")
+	ErrAborted            = errors.New("aborted")
+	ErrInternal           = errors.New("internal error")
+)
+
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// Error carries the kind of a failure together with a machine-readable
+// reason and the metadata needed to build a useful response for clients.
+type Error struct {
+	Kind       error
+	Reason     string
+	Message    string
+	Metadata   map[string]string
+	Violations []FieldViolation
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
+
+func NewError(kind error, reason string, metadata map[string]string, format string, args ...any) *Error {
+	return &Error{
+		Kind:     kind,
+		Reason:   reason,
+		Message:  fmt.Sprintf(format, args...),
+		Metadata: metadata,
+	}
+}
+
+func InvalidArgumentError(reason string, violations []FieldViolation, format string, args ...any) *Error {
+	err := NewError(ErrInvalidArgument, reason, nil, format, args...)
+	err.Violations = violations
+	return err
+}
+
+func SchemaNotFoundError(id string) *Error {
+	return NewError(ErrNotFound, "SCHEMA_NOT_FOUND", map[string]string{"schema_id": id}, "schema with id=<%s> not found", id)
+}
+
+func SchemaNameNotFoundError(name string) *Error {
+	return NewError(ErrNotFound, "SCHEMA_NOT_FOUND", map[string]string{"schema_name": name}, "schema with name '%s' not found", name)
+}
+
+func SlugNotFoundError(slug string) *Error {
+	return NewError(ErrNotFound, "SCHEMA_NOT_FOUND", map[string]string{"slug": slug}, "schema with slug '%s' not found", slug)
+}
+
+func SchemaVersionNotFoundError(id string, version int64) *Error {
+	return NewError(ErrNotFound, "SCHEMA_VERSION_NOT_FOUND", map[string]string{"schema_id": id, "version": fmt.Sprint(version)},
+		"version %d of schema with id=<%s> not found", version, id)
+}
+
+func SchemaNameTakenError(name string) *Error {
+	return NewError(ErrAlreadyExists, "SCHEMA_NAME_TAKEN", map[string]string{"schema_name": name}, "schema with name '%s' already exists", name)
+}
+
+func InternalError(reason string, format string, args ...any) *Error {
+	return NewError(ErrInternal, reason, nil, format, args...)
+}
@@ -0,0 +1,69 @@
+package domain
+
+import "time"
+
+type EventType string
+
+const (
+	EventCreated EventType = "CREATED"
+	EventUpdated EventType = "UPDATED"
+	EventDeleted EventType = "DELETED"
+)
+
+type SchemaEvent struct {
+	Revision  int64     `json:"revision"`
+	Type      EventType `json:"type"`
+	SchemaID  string    `json:"schema_id"`
+	Schema    Schema    `json:"schema"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// EventFilter selects the events a watcher is interested in. Empty fields
+// match every event.
+type EventFilter struct {
+	AuthorID  string
+	SchemaIDs []string
+}
+
+func (f EventFilter) Matches(e SchemaEvent) bool {
+	if f.AuthorID != "" && e.Schema.AuthorID != f.AuthorID {
+		return false
+	}
+	if len(f.SchemaIDs) == 0 {
+		return true
+	}
+	for _, id := range f.SchemaIDs {
+		if id == e.SchemaID {
+			return true
+		}
+	}
+	return false
+}
+
+// EventStream delivers the events of a watch. Events is closed when the
+// stream ends; Err then tells why. Close must be called once done.
+type EventStream interface {
+	Events() <-chan SchemaEvent
+	Err() error
+	Close()
+}
+
+// Tombstone records the deletion of a schema for incremental sync. The
+// published versions of the schema are kept and can still be read, and its
+// parent links the lineage of its derivatives.
+type Tombstone struct {
+	SchemaID  string          `json:"schema_id"`
+	Revision  int64           `json:"revision"`
+	DeletedAt time.Time       `json:"deleted_at"`
+	ParentID  string          `json:"parent_schema_id,omitempty"`
+	Versions  []SchemaVersion `json:"versions,omitempty"`
+}
+
+// ChangeSet holds the changes after a revision, ordered by revision, and the
+// high-water mark to continue from.
+type ChangeSet struct {
+	Schemas    []Schema
+	Tombstones []Tombstone
+	Revision   int64
+	HasMore    bool
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// ExportFilter selects the schemas to export. Empty fields match every schema.
+type ExportFilter struct {
+	AuthorIDs    []string
+	SchemaIDs    []string
+	NamePrefix   string
+	UpdatedSince time.Time
+	States       []SchemaState
+}
+
+func (f ExportFilter) Matches(schema Schema) bool {
+	if len(f.AuthorIDs) > 0 && !contains(f.AuthorIDs, schema.AuthorID) {
+		return false
+	}
+	if len(f.SchemaIDs) > 0 && !contains(f.SchemaIDs, schema.SchemaID) {
+		return false
+	}
+	if !strings.HasPrefix(schema.SchemaName, f.NamePrefix) || !schema.InStates(f.States) {
+		return false
+	}
+	return !schema.UpdatedAt.Before(f.UpdatedSince)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Snapshot holds the schemas of the store as of Revision, in creation order.
+type Snapshot struct {
+	Schemas  []Schema
+	Revision int64
+}
@@ -0,0 +1,191 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+type taskRef struct {
+	task   *Task
+	parent *Task // nil for root tasks
+	index  int   // position among its siblings
+	field  string
+	path   []int64
+	depth  int
+}
+
+// TaskGraph is the dependency graph of a task tree: every task, whatever its
+// depth, is a node with an edge to each task of its blocked_by.
+type TaskGraph struct {
+	nodes []taskRef         // every task in tree order, parents first
+	byID  map[int64]taskRef // first task with each id
+}
+
+// NewTaskGraph flattens tasks into their dependency graph.
+func NewTaskGraph(tasks []Task) *TaskGraph {
+	g := &TaskGraph{byID: make(map[int64]taskRef)}
+	var walk func(children []Task, prefix string, path []int64, depth int, parent *Task)
+	walk = func(children []Task, prefix string, path []int64, depth int, parent *Task) {
+		for i := range children {
+			task := &children[i]
+			ref := taskRef{
+				task:   task,
+				parent: parent,
+				index:  i,
+				field:  fmt.Sprintf("%s[%d]", prefix, i),
+				path:   append(append([]int64{}, path...), int64(task.ID)),
+				depth:  depth,
+			}
+			g.nodes = append(g.nodes, ref)
+			if _, ok := g.byID[int64(task.ID)]; !ok {
+				g.byID[int64(task.ID)] = ref
+			}
+			walk(task.Children, ref.field+".children", ref.path, depth+1, task)
+		}
+	}
+	walk(tasks, "tasks", nil, 1, nil)
+	return g
+}
+
+// Diagnostics reports the blocked_by references that keep the tasks from
+// ever running: self-blocks, unknown tasks and cycles, each cycle once with
+// the ids along it.
+func (g *TaskGraph) Diagnostics() []Diagnostic {
+	var diagnostics []Diagnostic
+	for _, ref := range g.nodes {
+		for j, blocker := range ref.task.BlockedBy {
+			field := fmt.Sprintf(".blocked_by[%d]", j)
+			if blocker == int64(ref.task.ID) {
+				diagnostics = append(diagnostics, newDiagnostic(SeverityError, "SELF_BLOCKED", ref, field,
+					"task %d is blocked by itself", ref.task.ID))
+			} else if _, ok := g.byID[blocker]; !ok {
+				diagnostics = append(diagnostics, newDiagnostic(SeverityError, "UNKNOWN_BLOCKER", ref, field,
+					"task %d is blocked by unknown task %d", ref.task.ID, blocker))
+			}
+		}
+	}
+
+	for _, cycle := range g.cycles() {
+		ids := make([]string, len(cycle))
+		for i, id := range cycle {
+			ids[i] = fmt.Sprint(id)
+		}
+		diagnostics = append(diagnostics, newDiagnostic(SeverityError, "BLOCKED_BY_CYCLE", g.byID[cycle[0]], ".blocked_by",
+			"tasks block each other in a cycle: %s", strings.Join(ids, " -> ")))
+	}
+	return diagnostics
+}
+
+// cycles finds the cycles of the graph, ignoring self-blocks and unknown ids,
+// each as the list of ids along the cycle starting and ending with the same
+// id.
+func (g *TaskGraph) cycles() [][]int64 {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[int64]int)
+	var stack []int64
+	var cycles [][]int64
+
+	var visit func(id int64)
+	visit = func(id int64) {
+		state[id] = visiting
+		stack = append(stack, id)
+		for _, blocker := range g.byID[id].task.BlockedBy {
+			if _, ok := g.byID[blocker]; !ok || blocker == id {
+				continue
+			}
+			switch state[blocker] {
+			case unvisited:
+				visit(blocker)
+			case visiting:
+				// The cycle is the part of the stack from blocker on
+				for i := len(stack) - 1; i >= 0; i-- {
+					if stack[i] == blocker {
+						cycle := append(append([]int64{}, stack[i:]...), blocker)
+						cycles = append(cycles, cycle)
+						break
+					}
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[id] = visited
+	}
+
+	for _, ref := range g.nodes {
+		if id := int64(ref.task.ID); g.byID[id].task == ref.task && state[id] == unvisited {
+			visit(id)
+		}
+	}
+	return cycles
+}
+
+// ValidateTaskGraph rejects tasks whose blocked_by references are dangling,
+// self-referencing or cyclic, with one violation per problem.
+func ValidateTaskGraph(tasks []Task) error {
+	diagnostics := NewTaskGraph(tasks).Diagnostics()
+	if len(diagnostics) == 0 {
+		return nil
+	}
+
+	violations := make([]FieldViolation, len(diagnostics))
+	for i, d := range diagnostics {
+		violations[i] = FieldViolation{Field: d.Field, Description: d.Message}
+	}
+	return InvalidArgumentError("INVALID_TASK_GRAPH", violations, "invalid blocked_by: %s", diagnostics[0].Message)
+}
+
+// ValidateTaskIDs rejects tasks reusing an id anywhere in the tree. With
+// assignIDs the server gives ids to the tasks left at zero, which blocked_by
+// therefore cannot refer to.
+func ValidateTaskIDs(tasks []Task, assignIDs bool) error {
+	graph := NewTaskGraph(tasks)
+	var violations []FieldViolation
+	for _, ref := range graph.nodes {
+		id := int64(ref.task.ID)
+		if first := graph.byID[id]; first.task != ref.task && !(assignIDs && id == 0) {
+			violations = append(violations, FieldViolation{
+				Field:       ref.field + ".id",
+				Description: fmt.Sprintf("task id %d is already used by %s", id, first.field),
+			})
+		}
+		if !assignIDs {
+			continue
+		}
+		for j, blocker := range ref.task.BlockedBy {
+			if blocker == 0 {
+				violations = append(violations, FieldViolation{
+					Field:       fmt.Sprintf("%s.blocked_by[%d]", ref.field, j),
+					Description: "tasks without an id cannot be referenced",
+				})
+			}
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return InvalidArgumentError("INVALID_TASK_ID", violations, "invalid task ids: %s", violations[0].Description)
+}
+
+// ValidateTaskLevels rejects tasks whose level contradicts their depth in the
+// tree. A level of zero is left to be derived from the depth.
+func ValidateTaskLevels(tasks []Task) error {
+	var violations []FieldViolation
+	for _, ref := range NewTaskGraph(tasks).nodes {
+		if ref.task.Level != 0 && ref.task.Level != ref.depth {
+			violations = append(violations, FieldViolation{
+				Field:       ref.field + ".level",
+				Description: fmt.Sprintf("task is at depth %d but has level %d", ref.depth, ref.task.Level),
+			})
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return InvalidArgumentError("INVALID_TASK_LEVEL", violations, "invalid task levels: %s", violations[0].Description)
+}
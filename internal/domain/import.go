@@ -0,0 +1,37 @@
+package domain
+
+type ConflictPolicy string
+
+const (
+	ConflictFail      ConflictPolicy = "FAIL"
+	ConflictSkip      ConflictPolicy = "SKIP"
+	ConflictOverwrite ConflictPolicy = "OVERWRITE"
+	ConflictRename    ConflictPolicy = "RENAME"
+)
+
+type ImportOutcome string
+
+const (
+	ImportCreated     ImportOutcome = "CREATED"
+	ImportRenamed     ImportOutcome = "RENAMED"
+	ImportOverwritten ImportOutcome = "OVERWRITTEN"
+	ImportSkipped     ImportOutcome = "SKIPPED"
+	ImportInvalid     ImportOutcome = "INVALID"
+	ImportFailed      ImportOutcome = "FAILED"
+)
+
+// ImportItem is a schema to import together with its position in the import.
+// Only the author, name and tasks of Schema are used.
+type ImportItem struct {
+	Index  int
+	Schema Schema
+}
+
+type ImportResult struct {
+	Index       int
+	Outcome     ImportOutcome
+	SchemaID    string
+	SchemaName  string
+	Err         error
+	Diagnostics []Diagnostic
+}
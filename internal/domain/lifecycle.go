@@ -0,0 +1,77 @@
+package domain
+
+import "time"
+
+type SchemaState string
+
+const (
+	StateDraft      SchemaState = "DRAFT"
+	StateInReview   SchemaState = "IN_REVIEW"
+	StateApproved   SchemaState = "APPROVED"
+	StatePublished  SchemaState = "PUBLISHED"
+	StateDeprecated SchemaState = "DEPRECATED"
+	StateArchived   SchemaState = "ARCHIVED"
+)
+
+// stateTransitions lists the states a schema can move to from each state.
+// Archived schemas stay archived.
+var stateTransitions = map[SchemaState][]SchemaState{
+	StateDraft:      {StateInReview, StatePublished, StateArchived},
+	StateInReview:   {StateDraft, StateApproved},
+	StateApproved:   {StateDraft, StatePublished},
+	StatePublished:  {StateDraft, StateDeprecated},
+	StateDeprecated: {StatePublished, StateArchived},
+}
+
+// StateChange records a transition of the lifecycle of a schema. Actor is
+// the caller id, empty for anonymous callers.
+type StateChange struct {
+	From    SchemaState `json:"from"`
+	To      SchemaState `json:"to"`
+	Actor   string      `json:"actor"`
+	Time    time.Time   `json:"time"`
+	Comment string      `json:"comment,omitempty"`
+}
+
+func (s SchemaState) CanTransitionTo(to SchemaState) bool {
+	for _, state := range stateTransitions[s] {
+		if state == to {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckTransition fails with ErrFailedPrecondition when a schema in state
+// from cannot move to state to.
+func CheckTransition(from SchemaState, to SchemaState) error {
+	if !from.CanTransitionTo(to) {
+		return NewError(ErrFailedPrecondition, "INVALID_TRANSITION", map[string]string{"from": string(from), "to": string(to)},
+			"schema in state %s cannot move to state %s", from, to)
+	}
+	return nil
+}
+
+// InStates tells whether the schema is in one of states. No states match
+// every schema.
+func (s Schema) InStates(states []SchemaState) bool {
+	if len(states) == 0 {
+		return true
+	}
+	for _, state := range states {
+		if s.State == state {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckDeletable fails with ErrFailedPrecondition when the schema has
+// published versions and is not archived yet.
+func (s Schema) CheckDeletable() error {
+	if s.LatestVersion() > 0 && s.State != StateArchived {
+		return NewError(ErrFailedPrecondition, "SCHEMA_PUBLISHED", map[string]string{"schema_id": s.SchemaID, "state": string(s.State)},
+			"schema with id=<%s> has published versions and must be archived before deletion", s.SchemaID)
+	}
+	return nil
+}
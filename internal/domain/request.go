@@ -0,0 +1,22 @@
+package domain
+
+import "context"
+
+// RequestInfo identifies a call and its caller in every layer handling it.
+type RequestInfo struct {
+	RequestID   string
+	CallerID    string   // empty for anonymous callers
+	CallerRoles []string // roles of the caller, used by reviews
+}
+
+type requestInfoKey struct{}
+
+func WithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// RequestInfoFrom returns the RequestInfo attached to ctx, or the zero value.
+func RequestInfoFrom(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(RequestInfo)
+	return info
+}
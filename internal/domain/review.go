@@ -0,0 +1,113 @@
+package domain
+
+import "time"
+
+// ReviewPolicy is what a review needs for a schema to be approved. The zero
+// policy needs no review, and schemas can then be published from DRAFT.
+type ReviewPolicy struct {
+	MinApprovals  int      `json:"min_approvals"`
+	RequiredRoles []string `json:"required_roles,omitempty"` // each held by at least one approver
+}
+
+func (p ReviewPolicy) RequiresReview() bool {
+	return p.MinApprovals > 0 || len(p.RequiredRoles) > 0
+}
+
+type ReviewStatus string
+
+const (
+	ReviewOpen      ReviewStatus = "OPEN"
+	ReviewApproved  ReviewStatus = "APPROVED"
+	ReviewRejected  ReviewStatus = "REJECTED"
+	ReviewWithdrawn ReviewStatus = "WITHDRAWN" // the schema went back to DRAFT before a decision
+)
+
+type ReviewDecision string
+
+const (
+	DecisionApprove ReviewDecision = "APPROVE"
+	DecisionReject  ReviewDecision = "REJECT"
+)
+
+// Review is a round of review of the draft, opened when the schema moves to
+// IN_REVIEW and closed when it leaves it.
+type Review struct {
+	Number      int64          `json:"number"`
+	RequestedBy string         `json:"requested_by"`
+	RequestedAt time.Time      `json:"requested_at"`
+	Comment     string         `json:"comment,omitempty"`
+	Policy      ReviewPolicy   `json:"policy"` // policy in force when the review was requested
+	Status      ReviewStatus   `json:"status"`
+	Records     []ReviewRecord `json:"records,omitempty"`
+}
+
+// ReviewRecord is the decision of one reviewer, with the roles they held at
+// the time.
+type ReviewRecord struct {
+	Reviewer string         `json:"reviewer"`
+	Roles    []string       `json:"roles,omitempty"`
+	Decision ReviewDecision `json:"decision"`
+	Comment  string         `json:"comment,omitempty"`
+	Time     time.Time      `json:"time"`
+}
+
+// OpenReview returns the index of the open review of the schema, or -1.
+func (s Schema) OpenReview() int {
+	if n := len(s.Reviews); n > 0 && s.Reviews[n-1].Status == ReviewOpen {
+		return n - 1
+	}
+	return -1
+}
+
+// RecordOf returns the decision of reviewer in the review, if any.
+func (r Review) RecordOf(reviewer string) (ReviewRecord, bool) {
+	for _, record := range r.Records {
+		if record.Reviewer == reviewer {
+			return record, true
+		}
+	}
+	return ReviewRecord{}, false
+}
+
+// Approvals counts the approvals of the review.
+func (r Review) Approvals() int {
+	approvals := 0
+	for _, record := range r.Records {
+		if record.Decision == DecisionApprove {
+			approvals++
+		}
+	}
+	return approvals
+}
+
+// MissingRoles lists the roles of the policy held by no approver yet.
+func (r Review) MissingRoles() []string {
+	var missing []string
+	for _, role := range r.Policy.RequiredRoles {
+		held := false
+		for _, record := range r.Records {
+			if record.Decision == DecisionApprove && hasRole(record.Roles, role) {
+				held = true
+				break
+			}
+		}
+		if !held {
+			missing = append(missing, role)
+		}
+	}
+	return missing
+}
+
+// Satisfied tells whether the approvals of the review meet its policy.
+func (r Review) Satisfied() bool {
+	return r.Approvals() >= r.Policy.MinApprovals && len(r.MissingRoles()) == 0
+}
+
+func hasRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
@@ -3,17 +3,20 @@ package domain
 import "time"
 
 type Task struct {
-	ID          int     `json:"id"`
-	Level       int     `json:"level"`
-	Name        string  `json:"name"`
-	Status      string  `json:"status"`
-	BlockedBy   []int64 `json:"blocked_by"`
-	Responsible string  `json:"responsible"`
-	TimeLimit   int64   `json:"time_limit"`
-	Children    []Task  `json:"children"`
+	ID          int        `json:"id"`
+	Level       int        `json:"level"`
+	Name        string     `json:"name"`
+	Status      TaskStatus `json:"status"`
+	BlockedBy   []int64    `json:"blocked_by"`
+	Responsible string     `json:"responsible"`
+	TimeLimit   int64      `json:"time_limit"`
+	Children    []Task     `json:"children"`
 	Comment     struct {
 		Value string `json:"value"`
 	} `json:"comment"`
+	// Only exposed by API version 2
+	Description string   `json:"description,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
 }
 
 type Schema struct {
@@ -24,4 +27,122 @@ type Schema struct {
 	UpdatedAt  time.Time `json:"updated_at"`
 	DeletedAt  time.Time `json:"deleted_at"`
 	Tasks      []Task    `json:"tasks"`
+	Revision   int64     `json:"revision"`
+	ParentID   string    `json:"parent_schema_id"` // schema this one was cloned from, if any
+	Slug       string    `json:"slug"`
+	// Former slugs, which keep resolving to the schema after a rename
+	SlugAliases []string `json:"slug_aliases,omitempty"`
+	// Published versions, oldest first, numbered from 1
+	Versions []SchemaVersion `json:"versions,omitempty"`
+	State    SchemaState     `json:"state"`
+	// Transitions of State, oldest first
+	StateHistory []StateChange `json:"state_history,omitempty"`
+	Reviews      []Review      `json:"reviews,omitempty"`
+}
+
+// SchemaResult is the outcome of a bulk operation for a single schema id.
+// Err is nil when the operation succeeded for that id.
+type SchemaResult struct {
+	SchemaID string
+	Schema   Schema
+	Err      error
+}
+
+// CloneTasks returns a deep copy of tasks sharing no slices with the original.
+func CloneTasks(tasks []Task) []Task {
+	if tasks == nil {
+		return nil
+	}
+	clone := make([]Task, len(tasks))
+	for i, task := range tasks {
+		clone[i] = task
+		if task.BlockedBy != nil {
+			clone[i].BlockedBy = append([]int64{}, task.BlockedBy...)
+		}
+		if task.Labels != nil {
+			clone[i].Labels = append([]string{}, task.Labels...)
+		}
+		clone[i].Children = CloneTasks(task.Children)
+	}
+	return clone
+}
+
+// SetTaskLevels sets the level of tasks and their children to their depth in
+// the tree, 1 for the root tasks. The tasks are modified in place.
+func SetTaskLevels(tasks []Task) {
+	var set func(tasks []Task, level int)
+	set = func(tasks []Task, level int) {
+		for i := range tasks {
+			tasks[i].Level = level
+			set(tasks[i].Children, level+1)
+		}
+	}
+	set(tasks, 1)
+}
+
+// RenumberTasks numbers tasks and their children from 1 in tree order
+// (parents before children) and rewrites blocked_by to the new ids. Blockers
+// that are not part of the tree cannot be remapped and are dropped. The tasks
+// are modified in place.
+func RenumberTasks(tasks []Task) {
+	ids := make(map[int64]int64)
+	next := 1
+	var number func(tasks []Task)
+	number = func(tasks []Task) {
+		for i := range tasks {
+			if _, ok := ids[int64(tasks[i].ID)]; !ok {
+				ids[int64(tasks[i].ID)] = int64(next)
+			}
+			tasks[i].ID = next
+			next++
+			number(tasks[i].Children)
+		}
+	}
+	number(tasks)
+
+	var remap func(tasks []Task)
+	remap = func(tasks []Task) {
+		for i := range tasks {
+			if tasks[i].BlockedBy != nil {
+				blockedBy := []int64{}
+				for _, id := range tasks[i].BlockedBy {
+					if newID, ok := ids[id]; ok {
+						blockedBy = append(blockedBy, newID)
+					}
+				}
+				tasks[i].BlockedBy = blockedBy
+			}
+			remap(tasks[i].Children)
+		}
+	}
+	remap(tasks)
+}
+
+// AssignTaskIDs gives the tasks and children left at id zero the ids after
+// the largest one used, in tree order (parents before children). The other
+// ids are kept, so blocked_by stays valid. The tasks are modified in place.
+func AssignTaskIDs(tasks []Task) {
+	next := 1
+	var find func(tasks []Task)
+	find = func(tasks []Task) {
+		for i := range tasks {
+			if tasks[i].ID >= next {
+				next = tasks[i].ID + 1
+			}
+			find(tasks[i].Children)
+		}
+	}
+	find(tasks)
+
+	var assign func(tasks []Task)
+	assign = func(tasks []Task) {
+		for i := range tasks {
+			if tasks[i].ID == 0 {
+				tasks[i].ID = next
+				next++
+			}
+			assign(tasks[i].Children)
+		}
+	}
+	assign(tasks)
 }
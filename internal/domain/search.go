@@ -0,0 +1,21 @@
+package domain
+
+type SearchField string
+
+const (
+	SearchFieldSchemaName  SearchField = "SCHEMA_NAME"
+	SearchFieldTaskName    SearchField = "TASK_NAME"
+	SearchFieldTaskComment SearchField = "TASK_COMMENT"
+)
+
+type SearchMatch struct {
+	Field    SearchField `json:"field"`
+	TaskPath []int64     `json:"task_path"`
+	Snippet  string      `json:"snippet"`
+}
+
+type SearchResult struct {
+	Schema  Schema        `json:"schema"`
+	Score   float64       `json:"score"`
+	Matches []SearchMatch `json:"matches"`
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const maxSlugLen = 64
+
+// Slugify derives a URL-safe slug from a schema name: lower-case ASCII
+// letters and digits, other characters collapsing into single dashes, e.g.
+// "Sepsis Protocol (v2)" becomes "sepsis-protocol-v2". Diacritics are folded
+// first, so "Évaluation" becomes "evaluation". Names without any Latin letter
+// or digit get the slug "schema".
+func Slugify(name string) string {
+	var slug strings.Builder
+	dash := false
+	for _, r := range Fold(name) {
+		if !(r >= 'a' && r <= 'z') && !(r >= '0' && r <= '9') {
+			dash = slug.Len() > 0
+			continue
+		}
+		if dash && slug.Len()+2 > maxSlugLen || slug.Len()+1 > maxSlugLen {
+			break
+		}
+		if dash {
+			slug.WriteByte('-')
+			dash = false
+		}
+		slug.WriteRune(r)
+	}
+	if slug.Len() == 0 {
+		return "schema"
+	}
+	return slug.String()
+}
+
+// Fold lowercases s and strips diacritics, so "Évaluation" and "evaluation"
+// fold to the same string.
+func Fold(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range norm.NFD.String(s) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// FreeSlug returns base, or base suffixed with the first "-n" (n >= 2) for
+// which taken returns false.
+func FreeSlug(base string, taken func(slug string) bool) string {
+	if !taken(base) {
+		return base
+	}
+	for n := 2; ; n++ {
+		suffix := fmt.Sprintf("-%d", n)
+		candidate := base
+		if len(candidate)+len(suffix) > maxSlugLen {
+			candidate = strings.TrimSuffix(candidate[:maxSlugLen-len(suffix)], "-")
+		}
+		candidate += suffix
+		if !taken(candidate) {
+			return candidate
+		}
+	}
+}
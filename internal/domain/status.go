@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type TaskStatus string
+
+const (
+	TaskNotStarted TaskStatus = "NOT_STARTED"
+	TaskInProgress TaskStatus = "IN_PROGRESS"
+	TaskBlocked    TaskStatus = "BLOCKED"
+	TaskDone       TaskStatus = "DONE"
+)
+
+// ParseTaskStatus accepts exactly the names of the task statuses.
+func ParseTaskStatus(name string) (TaskStatus, error) {
+	status := TaskStatus(name)
+	if !status.Valid() {
+		return "", fmt.Errorf("unknown task status %q", name)
+	}
+	return status, nil
+}
+
+func (s TaskStatus) Valid() bool {
+	switch s {
+	case TaskNotStarted, TaskInProgress, TaskBlocked, TaskDone:
+		return true
+	}
+	return false
+}
+
+// MarshalJSON refuses to write a status that could not be read back.
+func (s TaskStatus) MarshalJSON() ([]byte, error) {
+	if !s.Valid() {
+		return nil, fmt.Errorf("unknown task status %q", string(s))
+	}
+	return json.Marshal(string(s))
+}
+
+func (s *TaskStatus) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+	status, err := ParseTaskStatus(name)
+	if err != nil {
+		return err
+	}
+	*s = status
+	return nil
+}
+
+// ValidateTaskStatuses rejects tasks without a known status.
+func ValidateTaskStatuses(tasks []Task) error {
+	var violations []FieldViolation
+	for _, ref := range NewTaskGraph(tasks).nodes {
+		if !ref.task.Status.Valid() {
+			violations = append(violations, FieldViolation{
+				Field:       ref.field + ".status",
+				Description: "must be one of NOT_STARTED, IN_PROGRESS, BLOCKED, DONE",
+			})
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return InvalidArgumentError("INVALID_TASK_STATUS", violations, "invalid task statuses: %s %s", violations[0].Field, violations[0].Description)
+}
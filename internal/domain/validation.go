@@ -0,0 +1,83 @@
+package domain
+
+import "fmt"
+
+type Severity string
+
+const (
+	SeverityError   Severity = "ERROR"
+	SeverityWarning Severity = "WARNING"
+	SeverityInfo    Severity = "INFO"
+)
+
+// Diagnostic is a finding about a schema draft. Field is the path of the
+// offending field as in the request, e.g. "tasks[1].children[0].blocked_by",
+// and TaskPath the ids of the tasks from the root to the offending task.
+type Diagnostic struct {
+	Severity Severity `json:"severity"`
+	Code     string   `json:"code"`
+	Field    string   `json:"field"`
+	TaskPath []int64  `json:"task_path"`
+	Message  string   `json:"message"`
+}
+
+// HasErrors tells whether any of the diagnostics is an error.
+func HasErrors(diagnostics []Diagnostic) bool {
+	for _, d := range diagnostics {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func newDiagnostic(severity Severity, code string, ref taskRef, field string, format string, args ...any) Diagnostic {
+	return Diagnostic{
+		Severity: severity,
+		Code:     code,
+		Field:    ref.field + field,
+		TaskPath: ref.path,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// ValidateSchema runs the structural and semantic checks of a schema that
+// need the whole task tree: task ids, blocked_by references, levels and time
+// limits. Per-field constraints are declared in the proto files instead.
+func ValidateSchema(schema Schema) []Diagnostic {
+	var diagnostics []Diagnostic
+	report := func(severity Severity, code string, ref taskRef, field string, format string, args ...any) {
+		diagnostics = append(diagnostics, newDiagnostic(severity, code, ref, field, format, args...))
+	}
+
+	if len(schema.Tasks) == 0 {
+		diagnostics = append(diagnostics, Diagnostic{
+			Severity: SeverityWarning,
+			Code:     "EMPTY_SCHEMA",
+			Field:    "tasks",
+			Message:  "schema has no tasks",
+		})
+		return diagnostics
+	}
+
+	// Check ids, levels and time limits in tree order
+	graph := NewTaskGraph(schema.Tasks)
+	for _, ref := range graph.nodes {
+		task := ref.task
+		if first := graph.byID[int64(task.ID)]; first.task != task {
+			report(SeverityError, "DUPLICATE_TASK_ID", ref, ".id",
+				"task id %d is already used by %s", task.ID, first.field)
+		}
+		if task.Level != 0 && task.Level != ref.depth {
+			report(SeverityError, "LEVEL_MISMATCH", ref, ".level",
+				"task is at depth %d but has level %d", ref.depth, task.Level)
+		}
+		if ref.parent != nil && ref.parent.TimeLimit > 0 && task.TimeLimit > ref.parent.TimeLimit {
+			report(SeverityWarning, "TIME_LIMIT_EXCEEDS_PARENT", ref, ".time_limit",
+				"time limit %d exceeds the time limit %d of the parent task", task.TimeLimit, ref.parent.TimeLimit)
+		}
+	}
+
+	// Check blocked_by references
+	return append(diagnostics, graph.Diagnostics()...)
+}
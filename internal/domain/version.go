@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"reflect"
+	"time"
+)
+
+// SchemaVersion is an immutable copy of the draft of a schema, made when it
+// is published. The name and tasks of the Schema itself are the draft.
+type SchemaVersion struct {
+	Version     int64     `json:"version"`
+	SchemaName  string    `json:"schema_name"`
+	Tasks       []Task    `json:"tasks"`
+	PublishedAt time.Time `json:"published_at"`
+	Revision    int64     `json:"revision"` // revision of the schema that was published
+}
+
+// LatestVersion is the number of the last published version, 0 if the
+// schema was never published.
+func (s Schema) LatestVersion() int64 {
+	return int64(len(s.Versions))
+}
+
+// DraftPublished tells whether the draft is the same as the last published
+// version.
+func (s Schema) DraftPublished() bool {
+	if len(s.Versions) == 0 {
+		return false
+	}
+	latest := s.Versions[len(s.Versions)-1]
+	return latest.SchemaName == s.SchemaName && reflect.DeepEqual(latest.Tasks, s.Tasks)
+}
+
+// DraftChanged tells whether the draft of a published schema has changes
+// not published yet.
+func (s Schema) DraftChanged() bool {
+	return s.LatestVersion() > 0 && !s.DraftPublished()
+}
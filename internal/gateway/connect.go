@@ -0,0 +1,262 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Names of the codes in Connect errors.
+var connectCodes = map[codes.Code]string{
+	codes.Canceled:           "canceled",
+	codes.Unknown:            "unknown",
+	codes.InvalidArgument:    "invalid_argument",
+	codes.DeadlineExceeded:   "deadline_exceeded",
+	codes.NotFound:           "not_found",
+	codes.AlreadyExists:      "already_exists",
+	codes.PermissionDenied:   "permission_denied",
+	codes.ResourceExhausted:  "resource_exhausted",
+	codes.FailedPrecondition: "failed_precondition",
+	codes.Aborted:            "aborted",
+	codes.OutOfRange:         "out_of_range",
+	codes.Unimplemented:      "unimplemented",
+	codes.Internal:           "internal",
+	codes.Unavailable:        "unavailable",
+	codes.DataLoss:           "data_loss",
+	codes.Unauthenticated:    "unauthenticated",
+}
+
+type connectError struct {
+	Code    string               `json:"code"`
+	Message string               `json:"message,omitempty"`
+	Details []connectErrorDetail `json:"details,omitempty"`
+}
+
+type connectErrorDetail struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+func connectErrorOf(st *status.Status) *connectError {
+	e := &connectError{Code: connectCodes[st.Code()], Message: st.Message()}
+	if e.Code == "" {
+		e.Code = "unknown"
+	}
+	for _, detail := range st.Proto().Details {
+		e.Details = append(e.Details, connectErrorDetail{
+			Type:  detail.TypeUrl[strings.LastIndex(detail.TypeUrl, "/")+1:],
+			Value: base64.RawStdEncoding.EncodeToString(detail.Value),
+		})
+	}
+	return e
+}
+
+// connectTimeout checks the Connect headers of r, returning the timeout of
+// the call.
+func connectTimeout(r *http.Request) (time.Duration, error) {
+	if version := r.Header.Get("Connect-Protocol-Version"); version != "" && version != "1" {
+		return 0, fmt.Errorf("unsupported connect-protocol-version %q", version)
+	}
+	for _, header := range []string{"Content-Encoding", "Connect-Content-Encoding"} {
+		if encoding := r.Header.Get(header); encoding != "" && encoding != "identity" {
+			return 0, status.Errorf(codes.Unimplemented, "unsupported %s %q", strings.ToLower(header), encoding)
+		}
+	}
+	value := r.Header.Get("Connect-Timeout-Ms")
+	if value == "" {
+		return 0, nil
+	}
+	ms, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || ms < 0 || len(value) > 10 {
+		return 0, fmt.Errorf("invalid connect-timeout-ms %q", value)
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+// requestStatus reports an error in the request itself, as InvalidArgument
+// unless it already carries a status.
+func requestStatus(err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+	return status.New(codes.InvalidArgument, err.Error())
+}
+
+// serveConnectUnary serves a Connect unary call: the body is the request
+// message and the response message, or a JSON error with the HTTP status
+// matching its code. Trailers are sent as Trailer- prefixed headers.
+func (g *Gateway) serveConnectUnary(w http.ResponseWriter, r *http.Request, method rpcMethod, c codec) {
+	fail := func(st *status.Status, header metadata.MD, trailer metadata.MD) {
+		setMetadataHeaders(w.Header(), header, "")
+		setMetadataHeaders(w.Header(), trailer, "Trailer-")
+		data, _ := json.Marshal(connectErrorOf(st))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(HTTPStatusFromCode(st.Code()))
+		w.Write(data)
+	}
+
+	// Decode the request
+	timeout, err := connectTimeout(r)
+	if err != nil {
+		fail(requestStatus(err), nil, nil)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxMessageSize))
+	if err != nil {
+		fail(requestStatus(readError(err)), nil, nil)
+		return
+	}
+	requests, err := decodeRequests(method, []envelope{{data: body}}, c)
+	if err != nil {
+		fail(requestStatus(err), nil, nil)
+		return
+	}
+	ctx, err := g.outgoingContext(r.Context(), r.Header)
+	if err != nil {
+		fail(requestStatus(err), nil, nil)
+		return
+	}
+	ctx, cancel := withTimeout(ctx, timeout)
+	defer cancel()
+
+	// Call the gRPC server
+	stream, err := g.openStream(ctx, method, requests)
+	if err != nil {
+		fail(status.Convert(err), nil, nil)
+		return
+	}
+	resp := method.output.New().Interface()
+	err = stream.RecvMsg(resp) // reads the trailers too for unary methods
+	header, _ := stream.Header()
+	if st := statusOf(err); st.Code() != codes.OK {
+		fail(st, header, stream.Trailer())
+		return
+	}
+
+	data, err := c.marshal(resp)
+	if err != nil {
+		fail(status.Newf(codes.Internal, "failed to encode response: %v", err), nil, nil)
+		return
+	}
+	setMetadataHeaders(w.Header(), header, "")
+	setMetadataHeaders(w.Header(), stream.Trailer(), "Trailer-")
+	w.Header().Set("Content-Type", "application/"+c.name)
+	w.Write(data)
+}
+
+// serveConnectStream serves a Connect streaming call: request and response
+// messages are enveloped, and the response ends with an end-of-stream
+// message holding the error, if any, and the trailers.
+func (g *Gateway) serveConnectStream(w http.ResponseWriter, r *http.Request, method rpcMethod, c codec) {
+	out := &connectStreamWriter{w: w, contentType: "application/connect+" + c.name}
+
+	// Decode the request
+	timeout, err := connectTimeout(r)
+	if err != nil {
+		out.finish(requestStatus(err), nil, nil)
+		return
+	}
+	envelopes, err := readEnvelopes(http.MaxBytesReader(w, r.Body, maxRequestSize))
+	if err != nil {
+		out.finish(requestStatus(err), nil, nil)
+		return
+	}
+	requests, err := decodeRequests(method, envelopes, c)
+	if err != nil {
+		out.finish(requestStatus(err), nil, nil)
+		return
+	}
+	ctx, err := g.outgoingContext(r.Context(), r.Header)
+	if err != nil {
+		out.finish(requestStatus(err), nil, nil)
+		return
+	}
+	ctx, cancel := withTimeout(ctx, timeout)
+	defer cancel()
+
+	// Call the gRPC server, relaying the responses as they arrive
+	stream, err := g.openStream(ctx, method, requests)
+	if err != nil {
+		out.finish(status.Convert(err), nil, nil)
+		return
+	}
+	for {
+		msg := method.output.New().Interface()
+		if err = stream.RecvMsg(msg); err != nil {
+			break
+		}
+		data, err := c.marshal(msg)
+		if err != nil {
+			out.finish(status.Newf(codes.Internal, "failed to encode response: %v", err), nil, nil)
+			return
+		}
+		header, _ := stream.Header()
+		if out.message(header, data) != nil {
+			return // client went away
+		}
+	}
+	header, _ := stream.Header()
+	out.finish(statusOf(err), header, stream.Trailer())
+}
+
+type connectStreamWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	started     bool
+}
+
+func (cw *connectStreamWriter) start(header metadata.MD) {
+	if cw.started {
+		return
+	}
+	cw.started = true
+	setMetadataHeaders(cw.w.Header(), header, "")
+	cw.w.Header().Set("Content-Type", cw.contentType)
+	cw.w.WriteHeader(http.StatusOK)
+}
+
+func (cw *connectStreamWriter) envelope(flags byte, data []byte) error {
+	var buf bytes.Buffer
+	writeEnvelope(&buf, flags, data)
+	_, err := cw.w.Write(buf.Bytes())
+	if flusher, ok := cw.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return err
+}
+
+func (cw *connectStreamWriter) message(header metadata.MD, data []byte) error {
+	cw.start(header)
+	return cw.envelope(0, data)
+}
+
+// finish writes the end-of-stream message, starting the response with header
+// if no message was sent.
+func (cw *connectStreamWriter) finish(st *status.Status, header metadata.MD, trailer metadata.MD) {
+	cw.start(header)
+
+	end := struct {
+		Error    *connectError       `json:"error,omitempty"`
+		Metadata map[string][]string `json:"metadata,omitempty"`
+	}{}
+	if st.Code() != codes.OK {
+		end.Error = connectErrorOf(st)
+	}
+	trailers := http.Header{}
+	setMetadataHeaders(trailers, trailer, "")
+	if len(trailers) > 0 {
+		end.Metadata = trailers
+	}
+	data, _ := json.Marshal(end)
+	cw.envelope(flagEndStream, data)
+}
@@ -0,0 +1,59 @@
+package gateway
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Headers browsers may send and read across origins. The caller id is left
+// out on purpose: it is set by the authenticating proxy, not by pages.
+var (
+	corsAllowedHeaders = []string{
+		"Content-Type", "Connect-Protocol-Version", "Connect-Timeout-Ms", "Grpc-Timeout",
+		"X-Grpc-Web", "X-User-Agent", "Idempotency-Key", "X-Request-Id",
+	}
+	corsExposedHeaders = []string{
+		"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin", "Idempotent-Replay", "X-Request-Id",
+	}
+	corsAllowedMethods = []string{http.MethodGet, http.MethodPost, http.MethodPatch, http.MethodDelete}
+)
+
+// How long browsers may cache the answer to a preflight request, in seconds.
+const corsMaxAge = "7200"
+
+// WithCORS lets pages served from allowedOrigins call h from browsers.
+// The origin "*" allows every origin.
+func WithCORS(h http.Handler, allowedOrigins []string) http.Handler {
+	allowed := make(map[string]bool)
+	for _, origin := range allowedOrigins {
+		allowed[strings.TrimSpace(origin)] = true
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		w.Header().Add("Vary", "Origin")
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+
+		if origin == "" || !(allowed["*"] || allowed[origin]) {
+			if preflight {
+				w.WriteHeader(http.StatusNoContent) // without CORS headers the browser refuses the call
+				return
+			}
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		if preflight {
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+			w.Header().Set("Access-Control-Allow-Methods", strings.Join(corsAllowedMethods, ", "))
+			w.Header().Set("Access-Control-Allow-Headers", strings.Join(corsAllowedHeaders, ", "))
+			w.Header().Set("Access-Control-Max-Age", corsMaxAge)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Access-Control-Expose-Headers", strings.Join(corsExposedHeaders, ", "))
+		h.ServeHTTP(w, r)
+	})
+}
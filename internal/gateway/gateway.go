@@ -0,0 +1,527 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	schema_service "server/proto"
+	schema_service_v2 "server/proto/v2"
+
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails" // resolve error details when encoding statuses
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Route maps an HTTP method and path onto an RPC of SchemaService. Path
+// parameters such as {schema_id} name request fields. With Body set the
+// request is read from the JSON body, otherwise from the query parameters.
+// Client streams read their messages from a newline-delimited JSON body.
+type Route struct {
+	Method  string
+	Pattern string
+	RPC     string
+	Body    bool
+}
+
+// Routes exposes every SchemaService RPC.
+var Routes = []Route{
+	{http.MethodGet, "/v1/schemas", "GetAllSchemas", false},
+	{http.MethodPost, "/v1/schemas", "CreateSchema", true},
+	{http.MethodGet, "/v1/schemas/{schema_id}", "GetSchemaByID", false},
+	{http.MethodDelete, "/v1/schemas/{schema_id}", "DeleteSchemaByID", false},
+	{http.MethodGet, "/v1/schemas:search", "SearchSchemas", false},
+	{http.MethodPost, "/v1/schemas:batchGet", "BatchGetSchemas", true},
+	{http.MethodPost, "/v1/schemas:batchDelete", "BatchDeleteSchemas", true},
+	{http.MethodGet, "/v1/schemas:watch", "WatchSchemas", false},
+	{http.MethodGet, "/v1/changes", "GetChangesSince", false},
+	{http.MethodPost, "/v1/schemas/{source_schema_id}:clone", "CloneSchema", true},
+	{http.MethodGet, "/v1/schemas/{schema_id}/derived", "ListDerivedSchemas", false},
+	{http.MethodPost, "/v1/schemas:validate", "ValidateSchema", true},
+	{http.MethodPost, "/v1/schemas:import", "ImportSchemas", true},
+	{http.MethodGet, "/v1/schemas:export", "ExportSchemas", false},
+	{http.MethodGet, "/v1/schemas:byName", "GetSchemaByName", false},
+	{http.MethodGet, "/v1/authors/{author_id}/schemas", "ListSchemasByAuthor", false},
+	{http.MethodGet, "/v1/slugs/{slug}", "GetSchemaBySlug", false},
+	{http.MethodPost, "/v1/schemas/{schema_id}:rename", "RenameSchema", true},
+	{http.MethodPatch, "/v1/schemas/{schema_id}", "UpdateSchema", true},
+	{http.MethodPost, "/v1/schemas/{schema_id}:publish", "PublishSchema", true},
+	{http.MethodGet, "/v1/schemas/{schema_id}/versions", "ListSchemaVersions", false},
+	{http.MethodGet, "/v1/schemas/{schema_id}/versions/{version}", "GetSchemaVersion", false},
+	{http.MethodPost, "/v1/schemas/{schema_id}:transition", "TransitionSchema", true},
+	{http.MethodPost, "/v1/schemas/{schema_id}:requestReview", "RequestSchemaReview", true},
+	{http.MethodPost, "/v1/schemas/{schema_id}:approve", "ApproveSchema", true},
+	{http.MethodPost, "/v1/schemas/{schema_id}:reject", "RejectSchema", true},
+	{http.MethodGet, "/v1/schemas:diff", "DiffSchemas", false},
+}
+
+// Request headers forwarded to the gRPC server as metadata, and response
+// metadata returned as headers. Caller headers are only forwarded by gateways
+// trusting the proxy in front of them, and dropped otherwise.
+var (
+	forwardedHeaders = []string{"idempotency-key", "x-request-id"}
+	callerHeaders    = []string{"x-caller-id", "x-caller-roles"}
+	returnedHeaders  = []string{"idempotent-replay", "x-request-id"}
+)
+
+var (
+	marshalOptions   = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+	unmarshalOptions = protojson.UnmarshalOptions{}
+)
+
+var serviceDescriptor = schema_service.File_proto_schema_service_proto.Services().ByName("SchemaService")
+
+// Services served over gRPC-Web and Connect. The REST/JSON routes only cover
+// version 1.
+var rpcServices = []protoreflect.ServiceDescriptor{
+	serviceDescriptor,
+	schema_service_v2.File_proto_v2_schema_service_proto.Services().ByName("SchemaService"),
+}
+
+type boundRoute struct {
+	Route
+	path   *regexp.Regexp
+	params []string
+	method protoreflect.MethodDescriptor
+	input  protoreflect.MessageType
+	output protoreflect.MessageType
+}
+
+// Gateway serves the REST/JSON API, and both versions of SchemaService over
+// gRPC-Web and the Connect protocol, by calling the gRPC server through conn, so requests go
+// through the same interceptors as native gRPC calls.
+// The OpenAPI document is served at OpenAPIPath.
+type Gateway struct {
+	conn         grpc.ClientConnInterface
+	routes       []boundRoute
+	methods      map[string]rpcMethod
+	openAPI      []byte
+	trustCallers bool
+}
+
+const OpenAPIPath = "/openapi.json"
+
+// New returns a gateway calling the gRPC server through conn. With
+// trustCallers the caller headers of requests are passed on, which is only
+// safe behind an authenticating proxy setting them on every request.
+func New(conn grpc.ClientConnInterface, trustCallers bool) (*Gateway, error) {
+	g := &Gateway{conn: conn, trustCallers: trustCallers}
+	for _, route := range Routes {
+		bound, err := bindRoute(route)
+		if err != nil {
+			return nil, err
+		}
+		g.routes = append(g.routes, bound)
+	}
+
+	methods, err := bindMethods(rpcServices)
+	if err != nil {
+		return nil, err
+	}
+	g.methods = methods
+
+	openAPI, err := OpenAPI()
+	if err != nil {
+		return nil, err
+	}
+	g.openAPI = openAPI
+
+	return g, nil
+}
+
+var paramPattern = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+func bindRoute(route Route) (boundRoute, error) {
+	method := serviceDescriptor.Methods().ByName(protoreflect.Name(route.RPC))
+	if method == nil {
+		return boundRoute{}, fmt.Errorf("route %s %s: unknown rpc %s", route.Method, route.Pattern, route.RPC)
+	}
+	input, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+	if err != nil {
+		return boundRoute{}, err
+	}
+	output, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return boundRoute{}, err
+	}
+
+	// Turn the pattern into a regexp capturing the path parameters
+	var params []string
+	expr := "^"
+	last := 0
+	for _, loc := range paramPattern.FindAllStringSubmatchIndex(route.Pattern, -1) {
+		name := route.Pattern[loc[2]:loc[3]]
+		if method.Input().Fields().ByName(protoreflect.Name(name)) == nil {
+			return boundRoute{}, fmt.Errorf("route %s %s: %s has no field %s", route.Method, route.Pattern, method.Input().FullName(), name)
+		}
+		params = append(params, name)
+		expr += regexp.QuoteMeta(route.Pattern[last:loc[0]]) + `([^/:]+)`
+		last = loc[1]
+	}
+	expr += regexp.QuoteMeta(route.Pattern[last:]) + "$"
+
+	return boundRoute{
+		Route:  route,
+		path:   regexp.MustCompile(expr),
+		params: params,
+		method: method,
+		input:  input,
+		output: output,
+	}, nil
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == OpenAPIPath && r.Method == http.MethodGet {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(g.openAPI)
+		return
+	}
+	if method, ok := g.methods[r.URL.Path]; ok {
+		g.serveRPC(w, r, method)
+		return
+	}
+
+	pathMatched := false
+	for _, route := range g.routes {
+		match := route.path.FindStringSubmatch(r.URL.Path)
+		if match == nil {
+			continue
+		}
+		pathMatched = true
+		if route.Method != r.Method {
+			continue
+		}
+		g.serve(w, r, route, match[1:])
+		return
+	}
+
+	if pathMatched {
+		writeError(w, status.Newf(codes.Unimplemented, "method %s not allowed for %s", r.Method, r.URL.Path), http.StatusMethodNotAllowed)
+		return
+	}
+	writeError(w, status.Newf(codes.NotFound, "no route for %s %s", r.Method, r.URL.Path), http.StatusNotFound)
+}
+
+func (g *Gateway) serve(w http.ResponseWriter, r *http.Request, route boundRoute, params []string) {
+	ctx := r.Context()
+	md := metadata.MD{}
+	headers := forwardedHeaders
+	if g.trustCallers {
+		headers = append(append([]string{}, forwardedHeaders...), callerHeaders...)
+	}
+	for _, header := range headers {
+		if values := r.Header.Values(header); len(values) > 0 {
+			md.Set(header, values...)
+		}
+	}
+	ctx = metadata.NewOutgoingContext(ctx, md)
+	fullMethod := "/" + string(serviceDescriptor.FullName()) + "/" + route.RPC
+
+	if route.method.IsStreamingClient() {
+		g.serveClientStream(ctx, w, r, route, fullMethod)
+		return
+	}
+
+	req, err := decodeRequest(r, route, params)
+	if err != nil {
+		writeError(w, status.New(codes.InvalidArgument, err.Error()), 0)
+		return
+	}
+
+	if route.method.IsStreamingServer() {
+		g.serveStream(ctx, w, route, fullMethod, req)
+		return
+	}
+
+	var header metadata.MD
+	resp := route.output.New().Interface()
+	if err := g.conn.Invoke(ctx, fullMethod, req, resp, grpc.Header(&header)); err != nil {
+		returnHeaders(w, header)
+		writeError(w, status.Convert(err), 0)
+		return
+	}
+
+	data, err := marshalOptions.Marshal(resp)
+	if err != nil {
+		writeError(w, status.Newf(codes.Internal, "failed to encode response: %v", err), 0)
+		return
+	}
+	returnHeaders(w, header)
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// serveStream writes the messages of a server stream as newline-delimited
+// JSON, each wrapped as {"result": ...}. An error ending the stream after the
+// response started is written as a last {"error": ...} line.
+func (g *Gateway) serveStream(ctx context.Context, w http.ResponseWriter, route boundRoute, fullMethod string, req proto.Message) {
+	desc := &grpc.StreamDesc{StreamName: route.RPC, ServerStreams: true}
+	stream, err := g.conn.NewStream(ctx, desc, fullMethod)
+	if err == nil {
+		err = stream.SendMsg(req)
+	}
+	if err == nil {
+		err = stream.CloseSend()
+	}
+	if err != nil {
+		writeError(w, status.Convert(err), 0)
+		return
+	}
+
+	// Wait for the first message so that failures to start the stream get
+	// a proper status code
+	msg := route.output.New().Interface()
+	err = stream.RecvMsg(msg)
+	if err != nil && err != io.EOF {
+		writeError(w, status.Convert(err), 0)
+		return
+	}
+
+	header, _ := stream.Header()
+	returnHeaders(w, header)
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+
+	for err == nil {
+		data, marshalErr := marshalOptions.Marshal(msg)
+		if marshalErr != nil {
+			err = status.Errorf(codes.Internal, "failed to encode message: %v", marshalErr)
+			break
+		}
+		fmt.Fprintf(w, "{\"result\":%s}\n", data)
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		msg = route.output.New().Interface()
+		err = stream.RecvMsg(msg)
+	}
+	if err != io.EOF && ctx.Err() == nil {
+		data, _ := marshalOptions.Marshal(status.Convert(err).Proto())
+		fmt.Fprintf(w, "{\"error\":%s}\n", data)
+	}
+}
+
+// Longest line accepted in a newline-delimited JSON body.
+const maxLineSize = 4 << 20
+
+// serveClientStream sends every line of the newline-delimited JSON body as a
+// message of a client stream and writes the single response as JSON.
+func (g *Gateway) serveClientStream(ctx context.Context, w http.ResponseWriter, r *http.Request, route boundRoute, fullMethod string) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // abandons the stream on early returns
+
+	desc := &grpc.StreamDesc{StreamName: route.RPC, ClientStreams: true}
+	stream, err := g.conn.NewStream(ctx, desc, fullMethod)
+	if err != nil {
+		writeError(w, status.Convert(err), 0)
+		return
+	}
+
+	scanner := bufio.NewScanner(r.Body)
+	scanner.Buffer(nil, maxLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		msg := route.input.New().Interface()
+		if err := unmarshalOptions.Unmarshal(scanner.Bytes(), msg); err != nil {
+			writeError(w, status.Newf(codes.InvalidArgument, "invalid body line %d: %v", line, err), 0)
+			return
+		}
+		// The server may end the stream early, its status is read below
+		if err := stream.SendMsg(msg); err != nil {
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		writeError(w, status.Newf(codes.InvalidArgument, "failed to read body: %v", err), 0)
+		return
+	}
+
+	resp := route.output.New().Interface()
+	err = stream.CloseSend()
+	if err == nil {
+		err = stream.RecvMsg(resp)
+	}
+	header, _ := stream.Header()
+	returnHeaders(w, header)
+	if err != nil {
+		writeError(w, status.Convert(err), 0)
+		return
+	}
+
+	data, err := marshalOptions.Marshal(resp)
+	if err != nil {
+		writeError(w, status.Newf(codes.Internal, "failed to encode response: %v", err), 0)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// serveRPC serves a gRPC-Web or Connect call, told apart by the content type.
+func (g *Gateway) serveRPC(w http.ResponseWriter, r *http.Request, method rpcMethod) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	streaming := method.desc.IsStreamingClient() || method.desc.IsStreamingServer()
+
+	switch {
+	case strings.HasPrefix(contentType, "application/grpc-web"):
+		g.serveGRPCWeb(w, r, method, contentType)
+	case (contentType == "application/proto" || contentType == "application/json") && !streaming:
+		c, _ := codecNamed(strings.TrimPrefix(contentType, "application/"))
+		g.serveConnectUnary(w, r, method, c)
+	case (contentType == "application/connect+proto" || contentType == "application/connect+json") && streaming:
+		c, _ := codecNamed(strings.TrimPrefix(contentType, "application/connect+"))
+		g.serveConnectStream(w, r, method, c)
+	default:
+		http.Error(w, fmt.Sprintf("unsupported content type %q for %s", contentType, method.fullMethod), http.StatusUnsupportedMediaType)
+	}
+}
+
+func decodeRequest(r *http.Request, route boundRoute, params []string) (proto.Message, error) {
+	req := route.input.New()
+
+	if route.Body {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read body: %v", err)
+		}
+		if len(strings.TrimSpace(string(body))) > 0 {
+			if err := unmarshalOptions.Unmarshal(body, req.Interface()); err != nil {
+				return nil, fmt.Errorf("invalid body: %v", err)
+			}
+		}
+	} else {
+		for key, values := range r.URL.Query() {
+			fd := findField(req.Descriptor(), key)
+			if fd == nil {
+				return nil, fmt.Errorf("unknown query parameter %q", key)
+			}
+			if err := setField(req, fd, values); err != nil {
+				return nil, fmt.Errorf("query parameter %q: %v", key, err)
+			}
+		}
+	}
+
+	// Path parameters take precedence over the body and the query
+	for i, name := range route.params {
+		fd := req.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if err := setField(req, fd, []string{params[i]}); err != nil {
+			return nil, fmt.Errorf("path parameter %q: %v", name, err)
+		}
+	}
+
+	return req.Interface(), nil
+}
+
+// findField looks a field up by its proto or JSON name.
+func findField(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if fd := md.Fields().ByName(protoreflect.Name(name)); fd != nil {
+		return fd
+	}
+	return md.Fields().ByJSONName(name)
+}
+
+func setField(m protoreflect.Message, fd protoreflect.FieldDescriptor, values []string) error {
+	if isTimestamp(fd) && !fd.IsList() && len(values) == 1 {
+		// Timestamps are written as in JSON, e.g. 2024-01-02T15:04:05Z
+		timestamp := &timestamppb.Timestamp{}
+		if err := unmarshalOptions.Unmarshal([]byte(strconv.Quote(values[0])), timestamp); err != nil {
+			return fmt.Errorf("invalid timestamp %q", values[0])
+		}
+		m.Set(fd, protoreflect.ValueOfMessage(timestamp.ProtoReflect()))
+		return nil
+	}
+	if fd.IsMap() || fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+		return fmt.Errorf("cannot be set from a string")
+	}
+	if !fd.IsList() && len(values) > 1 {
+		return fmt.Errorf("expected a single value")
+	}
+
+	for _, raw := range values {
+		value, err := parseScalar(fd, raw)
+		if err != nil {
+			return err
+		}
+		if fd.IsList() {
+			m.Mutable(fd).List().Append(value)
+		} else {
+			m.Set(fd, value)
+		}
+	}
+	return nil
+}
+
+func isTimestamp(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind && fd.Message().FullName() == "google.protobuf.Timestamp"
+}
+
+func parseScalar(fd protoreflect.FieldDescriptor, raw string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(raw), nil
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(raw)
+		return protoreflect.ValueOfBool(v), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(raw, 10, 32)
+		return protoreflect.ValueOfInt32(int32(v)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(raw, 10, 64)
+		return protoreflect.ValueOfInt64(v), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(raw, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(v)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(raw, 10, 64)
+		return protoreflect.ValueOfUint64(v), err
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(raw, 64)
+		return protoreflect.ValueOfFloat64(v), err
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(raw, 32)
+		return protoreflect.ValueOfFloat32(float32(v)), err
+	case protoreflect.EnumKind:
+		if value := fd.Enum().Values().ByName(protoreflect.Name(raw)); value != nil {
+			return protoreflect.ValueOfEnum(value.Number()), nil
+		}
+		v, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown value %q", raw)
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), nil
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(raw)), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported kind %s", fd.Kind())
+	}
+}
+
+func returnHeaders(w http.ResponseWriter, md metadata.MD) {
+	for _, header := range returnedHeaders {
+		for _, value := range md.Get(header) {
+			w.Header().Add(header, value)
+		}
+	}
+}
@@ -0,0 +1,392 @@
+package gateway_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"server/internal/gateway"
+	schema_service "server/proto"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Mocks
+
+type MockSchemaServer struct {
+	schema_service.UnimplementedSchemaServiceServer
+}
+
+func (mss *MockSchemaServer) GetSchemaByID(ctx context.Context, req *schema_service.GetSchemaByIDRequest) (*schema_service.GetSchemaByIDResponse, error) {
+	if req.SchemaId == "missing" {
+		return nil, status.Errorf(codes.NotFound, "schema with id=<%s> not found", req.SchemaId)
+	}
+	return &schema_service.GetSchemaByIDResponse{
+		SchemaId: req.SchemaId,
+		Schema:   &schema_service.Schema{SchemaId: req.SchemaId, Revision: 7},
+	}, nil
+}
+
+func (mss *MockSchemaServer) CreateSchema(ctx context.Context, req *schema_service.CreateSchemaRequest) (*schema_service.CreateSchemaResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if keys := md.Get("idempotency-key"); len(keys) > 0 && keys[0] == "retried" {
+		grpc.SetHeader(ctx, metadata.Pairs("idempotent-replay", "true"))
+	}
+	return &schema_service.CreateSchemaResponse{
+		Schema: &schema_service.Schema{SchemaId: "created", AuthorId: req.AuthorId, SchemaName: req.SchemaName, Tasks: req.Tasks},
+	}, nil
+}
+
+func (mss *MockSchemaServer) SearchSchemas(ctx context.Context, req *schema_service.SearchSchemasRequest) (*schema_service.SearchSchemasResponse, error) {
+	return &schema_service.SearchSchemasResponse{
+		Results: []*schema_service.SearchResult{
+			{Schema: &schema_service.Schema{SchemaName: req.Query}, Score: float64(req.Limit)},
+		},
+	}, nil
+}
+
+func (mss *MockSchemaServer) CloneSchema(ctx context.Context, req *schema_service.CloneSchemaRequest) (*schema_service.CloneSchemaResponse, error) {
+	return &schema_service.CloneSchemaResponse{
+		Schema: &schema_service.Schema{SchemaId: "clone", ParentSchemaId: req.SourceSchemaId, SchemaName: req.SchemaName},
+	}, nil
+}
+
+func (mss *MockSchemaServer) WatchSchemas(req *schema_service.WatchSchemasRequest, stream schema_service.SchemaService_WatchSchemasServer) error {
+	if req.FromRevision > 100 {
+		return status.Error(codes.FailedPrecondition, "revision is ahead")
+	}
+	for revision := req.FromRevision + 1; revision <= req.FromRevision+2; revision++ {
+		stream.Send(&schema_service.WatchSchemasResponse{
+			Event: &schema_service.SchemaEvent{Revision: revision, Type: schema_service.SchemaEventType_SCHEMA_EVENT_TYPE_CREATED},
+		})
+	}
+	return status.Error(codes.ResourceExhausted, "watcher fell behind")
+}
+
+func (mss *MockSchemaServer) ImportSchemas(stream schema_service.SchemaService_ImportSchemasServer) error {
+	summary := &schema_service.ImportSummary{}
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&schema_service.ImportSchemasResponse{Summary: summary})
+		}
+		if err != nil {
+			return err
+		}
+		if schema := req.GetSchema(); schema != nil {
+			if schema.SchemaName == "" {
+				return status.Error(codes.InvalidArgument, "missing schema name")
+			}
+			summary.Received++
+			summary.Created++
+		}
+	}
+}
+
+func (mss *MockSchemaServer) ExportSchemas(req *schema_service.ExportSchemasRequest, stream schema_service.SchemaService_ExportSchemasServer) error {
+	if err := stream.Send(&schema_service.ExportSchemasResponse{SnapshotRevision: 1, Total: 1}); err != nil {
+		return err
+	}
+	return stream.Send(&schema_service.ExportSchemasResponse{
+		Schema: &schema_service.Schema{SchemaId: "exported", UpdatedAt: req.UpdatedSince},
+	})
+}
+
+func newTestGateway(t *testing.T) http.Handler {
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	schema_service.RegisterSchemaServiceServer(server, &MockSchemaServer{})
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	g, err := gateway.New(conn, false)
+	if err != nil {
+		t.Fatalf("Failed to create gateway: %v", err)
+	}
+	return g
+}
+
+func do(g http.Handler, method string, target string, body string, header http.Header) (*httptest.ResponseRecorder, map[string]any) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, req)
+
+	var decoded map[string]any
+	json.Unmarshal(rec.Body.Bytes(), &decoded)
+	return rec, decoded
+}
+
+// Tests
+
+func TestRoutes(t *testing.T) {
+	methods := schema_service.File_proto_schema_service_proto.Services().ByName("SchemaService").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		name := string(methods.Get(i).Name())
+		found := false
+		for _, route := range gateway.Routes {
+			found = found || route.RPC == name
+		}
+		if !found {
+			t.Errorf("No route for %s", name)
+		}
+	}
+}
+
+func TestUnary(t *testing.T) {
+	g := newTestGateway(t)
+
+	t.Run("PathParameter", func(t *testing.T) {
+		rec, body := do(g, http.MethodGet, "/v1/schemas/abc", "", nil)
+
+		if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/json" {
+			t.Fatalf("Expected 200 JSON, got %d %s", rec.Code, rec.Body)
+		}
+
+		schema := body["schema"].(map[string]any)
+		if body["schema_id"] != "abc" || schema["revision"] != "7" {
+			t.Errorf("Unexpected body %v", body)
+		}
+	})
+
+	t.Run("ErrorStatus", func(t *testing.T) {
+		rec, body := do(g, http.MethodGet, "/v1/schemas/missing", "", nil)
+
+		if rec.Code != http.StatusNotFound || body["code"] != float64(codes.NotFound) || !strings.Contains(body["message"].(string), "missing") {
+			t.Errorf("Expected 404 with status body, got %d %s", rec.Code, rec.Body)
+		}
+	})
+
+	t.Run("Body", func(t *testing.T) {
+		header := http.Header{"Idempotency-Key": {"retried"}}
+		rec, body := do(g, http.MethodPost, "/v1/schemas",
+			`{"author_id": "author", "schemaName": "schema", "tasks": [{"id": "1", "status": "TASK_STATUS_DONE"}]}`, header)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected 200, got %d %s", rec.Code, rec.Body)
+		}
+
+		schema := body["schema"].(map[string]any)
+		task := schema["tasks"].([]any)[0].(map[string]any)
+		if schema["author_id"] != "author" || schema["schema_name"] != "schema" || task["status"] != "TASK_STATUS_DONE" {
+			t.Errorf("Unexpected body %v", body)
+		}
+
+		if rec.Header().Get("Idempotent-Replay") != "true" {
+			t.Errorf("Expected the idempotency key to be forwarded and the replay header returned")
+		}
+	})
+
+	t.Run("BodyAndPathParameter", func(t *testing.T) {
+		rec, body := do(g, http.MethodPost, "/v1/schemas/abc:clone", `{"author_id": "author", "schema_name": "copy"}`, nil)
+
+		schema, _ := body["schema"].(map[string]any)
+		if rec.Code != http.StatusOK || schema["parent_schema_id"] != "abc" || schema["schema_name"] != "copy" {
+			t.Errorf("Unexpected response %d %s", rec.Code, rec.Body)
+		}
+	})
+
+	t.Run("QueryParameters", func(t *testing.T) {
+		rec, body := do(g, http.MethodGet, "/v1/schemas:search?query=lactate&limit=5", "", nil)
+
+		result := body["results"].([]any)[0].(map[string]any)
+		if rec.Code != http.StatusOK || result["score"] != float64(5) || result["schema"].(map[string]any)["schema_name"] != "lactate" {
+			t.Errorf("Unexpected response %d %s", rec.Code, rec.Body)
+		}
+	})
+
+	t.Run("InvalidQueryParameter", func(t *testing.T) {
+		rec, _ := do(g, http.MethodGet, "/v1/schemas:search?query=lactate&limit=five", "", nil)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400, got %d", rec.Code)
+		}
+
+		rec, _ = do(g, http.MethodGet, "/v1/schemas:search?unknown=1", "", nil)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for unknown parameter, got %d", rec.Code)
+		}
+	})
+
+	t.Run("InvalidBody", func(t *testing.T) {
+		rec, _ := do(g, http.MethodPost, "/v1/schemas", `{"author_id": 12`, nil)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("UnknownRoute", func(t *testing.T) {
+		rec, _ := do(g, http.MethodGet, "/v1/tasks", "", nil)
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("Expected 404, got %d", rec.Code)
+		}
+
+		rec, _ = do(g, http.MethodPut, "/v1/schemas", "", nil)
+		if rec.Code != http.StatusMethodNotAllowed {
+			t.Errorf("Expected 405, got %d", rec.Code)
+		}
+	})
+}
+
+func TestStream(t *testing.T) {
+	g := newTestGateway(t)
+
+	t.Run("NDJSON", func(t *testing.T) {
+		rec, _ := do(g, http.MethodGet, "/v1/schemas:watch?from_revision=10", "", nil)
+
+		if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/x-ndjson" {
+			t.Fatalf("Expected 200 NDJSON, got %d %s", rec.Code, rec.Body)
+		}
+
+		var lines []map[string]any
+		scanner := bufio.NewScanner(rec.Body)
+		for scanner.Scan() {
+			var line map[string]any
+			if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+				t.Fatalf("Invalid line %q: %v", scanner.Text(), err)
+			}
+			lines = append(lines, line)
+		}
+
+		if len(lines) != 3 {
+			t.Fatalf("Expected 2 events and an error, got %v", lines)
+		}
+
+		event := lines[0]["result"].(map[string]any)["event"].(map[string]any)
+		if event["revision"] != "11" || event["type"] != "SCHEMA_EVENT_TYPE_CREATED" {
+			t.Errorf("Unexpected event %v", event)
+		}
+
+		if lines[2]["error"].(map[string]any)["code"] != float64(codes.ResourceExhausted) {
+			t.Errorf("Expected RESOURCE_EXHAUSTED error line, got %v", lines[2])
+		}
+	})
+
+	t.Run("TimestampQuery", func(t *testing.T) {
+		rec, _ := do(g, http.MethodGet, "/v1/schemas:export?updated_since=2024-01-02T15:04:05Z", "", nil)
+
+		if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"updated_at":"2024-01-02T15:04:05Z"`) {
+			t.Errorf("Expected the timestamp to be forwarded, got %d %s", rec.Code, rec.Body)
+		}
+
+		rec, _ = do(g, http.MethodGet, "/v1/schemas:export?updated_since=yesterday", "", nil)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for an invalid timestamp, got %d %s", rec.Code, rec.Body)
+		}
+	})
+
+	t.Run("FailsBeforeFirstMessage", func(t *testing.T) {
+		rec, body := do(g, http.MethodGet, "/v1/schemas:watch?from_revision=101", "", nil)
+
+		if rec.Code != http.StatusBadRequest || body["code"] != float64(codes.FailedPrecondition) {
+			t.Errorf("Expected 400 with status body, got %d %s", rec.Code, rec.Body)
+		}
+	})
+}
+
+func TestClientStream(t *testing.T) {
+	g := newTestGateway(t)
+
+	t.Run("NDJSON", func(t *testing.T) {
+		body := `{"options": {"conflict_policy": "IMPORT_CONFLICT_POLICY_SKIP"}}
+
+{"schema": {"author_id": "author", "schema_name": "first"}}
+{"schema": {"author_id": "author", "schema_name": "second"}}
+`
+		rec, decoded := do(g, http.MethodPost, "/v1/schemas:import", body, nil)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected 200, got %d %s", rec.Code, rec.Body)
+		}
+
+		summary := decoded["summary"].(map[string]any)
+		if summary["received"] != float64(2) || summary["created"] != float64(2) {
+			t.Errorf("Unexpected summary %v", summary)
+		}
+	})
+
+	t.Run("InvalidLine", func(t *testing.T) {
+		rec, decoded := do(g, http.MethodPost, "/v1/schemas:import", "{\"schema\": {}}\n{not json}\n", nil)
+
+		if rec.Code != http.StatusBadRequest || !strings.Contains(decoded["message"].(string), "line 2") {
+			t.Errorf("Expected 400 pointing at line 2, got %d %s", rec.Code, rec.Body)
+		}
+	})
+
+	t.Run("ServerError", func(t *testing.T) {
+		rec, decoded := do(g, http.MethodPost, "/v1/schemas:import", `{"schema": {"author_id": "author"}}`, nil)
+
+		if rec.Code != http.StatusBadRequest || decoded["code"] != float64(codes.InvalidArgument) {
+			t.Errorf("Expected 400 with status body, got %d %s", rec.Code, rec.Body)
+		}
+	})
+}
+
+func TestOpenAPI(t *testing.T) {
+	document, err := gateway.OpenAPI()
+	if err != nil {
+		t.Fatalf("Failed to generate document: %v", err)
+	}
+
+	t.Run("UpToDate", func(t *testing.T) {
+		committed, err := os.ReadFile("../../proto/schema_service.openapi.json")
+		if err != nil {
+			t.Fatalf("Failed to read committed document: %v", err)
+		}
+
+		if strings.TrimSpace(string(committed)) != string(document) {
+			t.Errorf("proto/schema_service.openapi.json is outdated, run go run cmd/scripts/openapi/gen_openapi.go")
+		}
+	})
+
+	t.Run("DescribesRoutes", func(t *testing.T) {
+		var decoded struct {
+			Paths map[string]map[string]struct {
+				OperationID string `json:"operationId"`
+			} `json:"paths"`
+		}
+		if err := json.Unmarshal(document, &decoded); err != nil {
+			t.Fatalf("Invalid document: %v", err)
+		}
+
+		for _, route := range gateway.Routes {
+			operation := decoded.Paths[route.Pattern][strings.ToLower(route.Method)]
+			if operation.OperationID != route.RPC {
+				t.Errorf("Expected %s %s to be described as %s, got %+v", route.Method, route.Pattern, route.RPC, operation)
+			}
+		}
+	})
+
+	t.Run("Served", func(t *testing.T) {
+		g := newTestGateway(t)
+		rec, _ := do(g, http.MethodGet, gateway.OpenAPIPath, "", nil)
+		served, _ := io.ReadAll(rec.Body)
+
+		if rec.Code != http.StatusOK || string(served) != string(document) {
+			t.Errorf("Expected the document to be served, got %d", rec.Code)
+		}
+	})
+}
@@ -0,0 +1,209 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// serveGRPCWeb serves a gRPC-Web call. Messages are framed as in gRPC, in
+// base64 for the -text content types, and the status and trailers are sent
+// in a last frame flagged as trailers.
+func (g *Gateway) serveGRPCWeb(w http.ResponseWriter, r *http.Request, method rpcMethod, contentType string) {
+	subtype := strings.TrimPrefix(contentType, "application/grpc-web")
+	text := strings.HasPrefix(subtype, "-text")
+	subtype = strings.TrimPrefix(strings.TrimPrefix(subtype, "-text"), "+")
+	if subtype == "" {
+		subtype = "proto"
+	}
+	c, ok := codecNamed(subtype)
+	if !ok {
+		http.Error(w, fmt.Sprintf("unsupported content type %q", contentType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	responseType := "application/grpc-web+" + c.name
+	if text {
+		responseType = "application/grpc-web-text+" + c.name
+	}
+	out := &grpcWebWriter{w: w, text: text, contentType: responseType}
+
+	// Decode the request
+	var body io.Reader = http.MaxBytesReader(w, r.Body, maxRequestSize)
+	if text {
+		body = newBase64Reader(body)
+	}
+	envelopes, err := readEnvelopes(body)
+	if err != nil {
+		out.finish(status.New(codes.InvalidArgument, err.Error()), nil, nil)
+		return
+	}
+	requests, err := decodeRequests(method, envelopes, c)
+	if err != nil {
+		out.finish(status.New(codes.InvalidArgument, err.Error()), nil, nil)
+		return
+	}
+	timeout, err := parseGRPCTimeout(r.Header.Get("Grpc-Timeout"))
+	if err != nil {
+		out.finish(status.New(codes.InvalidArgument, err.Error()), nil, nil)
+		return
+	}
+	ctx, err := g.outgoingContext(r.Context(), r.Header)
+	if err != nil {
+		out.finish(status.New(codes.InvalidArgument, err.Error()), nil, nil)
+		return
+	}
+	ctx, cancel := withTimeout(ctx, timeout)
+	defer cancel()
+
+	// Call the gRPC server, relaying the responses as they arrive
+	stream, err := g.openStream(ctx, method, requests)
+	if err != nil {
+		out.finish(status.Convert(err), nil, nil)
+		return
+	}
+	for {
+		msg := method.output.New().Interface()
+		if err = stream.RecvMsg(msg); err != nil {
+			break
+		}
+		data, err := c.marshal(msg)
+		if err != nil {
+			out.finish(status.Newf(codes.Internal, "failed to encode response: %v", err), nil, nil)
+			return
+		}
+		header, _ := stream.Header()
+		if out.message(header, data) != nil {
+			return // client went away
+		}
+	}
+	header, _ := stream.Header()
+	out.finish(statusOf(err), header, stream.Trailer())
+}
+
+type grpcWebWriter struct {
+	w           http.ResponseWriter
+	text        bool
+	contentType string
+	started     bool
+}
+
+func (gw *grpcWebWriter) start(header metadata.MD) {
+	if gw.started {
+		return
+	}
+	gw.started = true
+	setMetadataHeaders(gw.w.Header(), header, "")
+	gw.w.Header().Set("Content-Type", gw.contentType)
+	gw.w.WriteHeader(http.StatusOK)
+}
+
+func (gw *grpcWebWriter) frame(flags byte, data []byte) error {
+	var buf bytes.Buffer
+	writeEnvelope(&buf, flags, data)
+	frame := buf.Bytes()
+	if gw.text {
+		frame = []byte(base64.StdEncoding.EncodeToString(frame))
+	}
+	_, err := gw.w.Write(frame)
+	if flusher, ok := gw.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return err
+}
+
+func (gw *grpcWebWriter) message(header metadata.MD, data []byte) error {
+	gw.start(header)
+	return gw.frame(0, data)
+}
+
+// finish writes the status and trailers of the call, starting the response
+// with header if no message was sent.
+func (gw *grpcWebWriter) finish(st *status.Status, header metadata.MD, trailer metadata.MD) {
+	gw.start(header)
+
+	var block strings.Builder
+	fmt.Fprintf(&block, "grpc-status: %d\r\n", st.Code())
+	fmt.Fprintf(&block, "grpc-message: %s\r\n", percentEncode(st.Message()))
+	if len(st.Proto().Details) > 0 {
+		if details, err := marshalStatus(st); err == nil {
+			fmt.Fprintf(&block, "grpc-status-details-bin: %s\r\n", base64.StdEncoding.EncodeToString(details))
+		}
+	}
+	trailers := http.Header{}
+	setMetadataHeaders(trailers, trailer, "")
+	for key, values := range trailers {
+		for _, value := range values {
+			fmt.Fprintf(&block, "%s: %s\r\n", strings.ToLower(key), value)
+		}
+	}
+	gw.frame(flagGRPCWebTrail, []byte(block.String()))
+}
+
+// percentEncode encodes a grpc-message value as gRPC requires.
+func percentEncode(message string) string {
+	var encoded strings.Builder
+	for i := 0; i < len(message); i++ {
+		if c := message[i]; c >= 0x20 && c <= 0x7e && c != '%' {
+			encoded.WriteByte(c)
+		} else {
+			fmt.Fprintf(&encoded, "%%%02X", c)
+		}
+	}
+	return encoded.String()
+}
+
+// base64Reader decodes a gRPC-Web text body, which may concatenate several
+// padded base64 chunks.
+type base64Reader struct {
+	r       io.Reader
+	group   []byte
+	decoded []byte
+}
+
+func newBase64Reader(r io.Reader) *base64Reader {
+	return &base64Reader{r: r}
+}
+
+func (br *base64Reader) Read(p []byte) (int, error) {
+	var buf [512]byte
+	for len(br.decoded) == 0 {
+		n, err := br.r.Read(buf[:])
+		for _, c := range buf[:n] {
+			if c == '\r' || c == '\n' || c == ' ' {
+				continue
+			}
+			br.group = append(br.group, c)
+			if len(br.group) == 4 {
+				decoded, decodeErr := base64.StdEncoding.DecodeString(string(br.group))
+				if decodeErr != nil {
+					return 0, fmt.Errorf("invalid base64 body")
+				}
+				br.decoded = append(br.decoded, decoded...)
+				br.group = br.group[:0]
+			}
+		}
+		if err == io.EOF && len(br.decoded) == 0 {
+			if len(br.group) > 0 {
+				return 0, fmt.Errorf("invalid base64 body")
+			}
+			return 0, io.EOF
+		}
+		if err != nil && err != io.EOF {
+			return 0, err
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+	n := copy(p, br.decoded)
+	br.decoded = br.decoded[n:]
+	return n, nil
+}
@@ -0,0 +1,216 @@
+package gateway
+
+import (
+	"encoding/json"
+	"strings"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Schemas of the well-known types, as encoded by protojson.
+var wellKnownSchemas = map[protoreflect.FullName]map[string]any{
+	"google.protobuf.Timestamp":   {"type": "string", "format": "date-time"},
+	"google.protobuf.Duration":    {"type": "string", "example": "1.5s"},
+	"google.protobuf.StringValue": {"type": "string", "nullable": true},
+	"google.protobuf.BoolValue":   {"type": "boolean", "nullable": true},
+	"google.protobuf.Int32Value":  {"type": "integer", "format": "int32", "nullable": true},
+	"google.protobuf.Int64Value":  {"type": "string", "format": "int64", "nullable": true},
+	"google.protobuf.DoubleValue": {"type": "number", "format": "double", "nullable": true},
+	"google.protobuf.Any": {
+		"type":                 "object",
+		"properties":           map[string]any{"@type": map[string]any{"type": "string"}},
+		"additionalProperties": true,
+	},
+}
+
+// OpenAPI returns the OpenAPI 3 document describing Routes.
+func OpenAPI() ([]byte, error) {
+	components := make(map[string]any)
+	paths := make(map[string]any)
+
+	for _, route := range Routes {
+		bound, err := bindRoute(route)
+		if err != nil {
+			return nil, err
+		}
+		input := bound.method.Input()
+
+		var parameters []any
+		inPath := make(map[string]bool)
+		for _, name := range bound.params {
+			inPath[name] = true
+			parameters = append(parameters, map[string]any{
+				"name":     name,
+				"in":       "path",
+				"required": true,
+				"schema":   fieldSchema(input.Fields().ByName(protoreflect.Name(name)), components),
+			})
+		}
+		if !route.Body {
+			fields := input.Fields()
+			for i := 0; i < fields.Len(); i++ {
+				fd := fields.Get(i)
+				if inPath[string(fd.Name())] || (fd.Kind() == protoreflect.MessageKind && !isTimestamp(fd)) || fd.IsMap() {
+					continue
+				}
+				parameter := map[string]any{
+					"name":   string(fd.Name()),
+					"in":     "query",
+					"schema": fieldSchema(fd, components),
+				}
+				if fd.IsList() {
+					parameter["explode"] = true
+				}
+				parameters = append(parameters, parameter)
+			}
+		}
+
+		operation := map[string]any{
+			"operationId": route.RPC,
+			"tags":        []string{string(serviceDescriptor.Name())},
+			"responses": map[string]any{
+				"200": map[string]any{
+					"description": "OK",
+					"content":     responseContent(bound, components),
+				},
+				"default": map[string]any{
+					"description": "Error",
+					"content": map[string]any{
+						"application/json": map[string]any{"schema": messageSchema(statusDescriptor(), components)},
+					},
+				},
+			},
+		}
+		if len(parameters) > 0 {
+			operation["parameters"] = parameters
+		}
+		if route.Body {
+			contentType := "application/json"
+			if bound.method.IsStreamingClient() {
+				contentType = "application/x-ndjson"
+				operation["description"] = "Reads the request messages as newline-delimited JSON objects."
+			}
+			operation["requestBody"] = map[string]any{
+				"required": true,
+				"content": map[string]any{
+					contentType: map[string]any{"schema": messageSchema(input, components)},
+				},
+			}
+		}
+		if bound.method.IsStreamingServer() {
+			operation["description"] = "Streams newline-delimited JSON objects holding either a result or the error that ended the stream."
+		}
+
+		path, _ := paths[route.Pattern].(map[string]any)
+		if path == nil {
+			path = make(map[string]any)
+			paths[route.Pattern] = path
+		}
+		path[strings.ToLower(route.Method)] = operation
+	}
+
+	document := map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   string(serviceDescriptor.FullName()),
+			"version": "v1",
+		},
+		"paths":      paths,
+		"components": map[string]any{"schemas": components},
+	}
+	return json.MarshalIndent(document, "", "  ")
+}
+
+func responseContent(route boundRoute, components map[string]any) map[string]any {
+	output := messageSchema(route.method.Output(), components)
+	if !route.method.IsStreamingServer() {
+		return map[string]any{"application/json": map[string]any{"schema": output}}
+	}
+	return map[string]any{
+		"application/x-ndjson": map[string]any{
+			"schema": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"result": output,
+					"error":  messageSchema(statusDescriptor(), components),
+				},
+			},
+		},
+	}
+}
+
+func statusDescriptor() protoreflect.MessageDescriptor {
+	return (&spb.Status{}).ProtoReflect().Descriptor()
+}
+
+// messageSchema returns a reference to the schema of md, adding it and the
+// messages it uses to components.
+func messageSchema(md protoreflect.MessageDescriptor, components map[string]any) map[string]any {
+	if schema, ok := wellKnownSchemas[md.FullName()]; ok {
+		return schema
+	}
+
+	name := string(md.FullName())
+	ref := map[string]any{"$ref": "#/components/schemas/" + name}
+	if _, ok := components[name]; ok {
+		return ref
+	}
+	properties := make(map[string]any)
+	schema := map[string]any{"type": "object", "properties": properties}
+	components[name] = schema // before the fields, for recursive messages
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		properties[string(fd.Name())] = fieldSchema(fd, components)
+	}
+	return ref
+}
+
+func fieldSchema(fd protoreflect.FieldDescriptor, components map[string]any) map[string]any {
+	var schema map[string]any
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if fd.IsMap() {
+			return map[string]any{
+				"type":                 "object",
+				"additionalProperties": fieldSchema(fd.MapValue(), components),
+			}
+		}
+		schema = messageSchema(fd.Message(), components)
+	case protoreflect.EnumKind:
+		name := string(fd.Enum().FullName())
+		if _, ok := components[name]; !ok {
+			var values []string
+			for i := 0; i < fd.Enum().Values().Len(); i++ {
+				values = append(values, string(fd.Enum().Values().Get(i).Name()))
+			}
+			components[name] = map[string]any{"type": "string", "enum": values}
+		}
+		schema = map[string]any{"$ref": "#/components/schemas/" + name}
+	case protoreflect.BoolKind:
+		schema = map[string]any{"type": "boolean"}
+	case protoreflect.StringKind:
+		schema = map[string]any{"type": "string"}
+	case protoreflect.BytesKind:
+		schema = map[string]any{"type": "string", "format": "byte"}
+	case protoreflect.DoubleKind:
+		schema = map[string]any{"type": "number", "format": "double"}
+	case protoreflect.FloatKind:
+		schema = map[string]any{"type": "number", "format": "float"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		schema = map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		schema = map[string]any{"type": "integer", "format": "uint32"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		schema = map[string]any{"type": "string", "format": "uint64"} // 64-bit integers are JSON strings
+	default:
+		schema = map[string]any{"type": "string", "format": "int64"}
+	}
+
+	if fd.IsList() {
+		return map[string]any{"type": "array", "items": schema}
+	}
+	return schema
+}
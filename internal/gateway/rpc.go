@@ -0,0 +1,278 @@
+package gateway
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Largest message, and largest request body, accepted in gRPC-Web and
+// Connect requests.
+const (
+	maxMessageSize = 4 << 20
+	maxRequestSize = 64 << 20
+)
+
+// rpcMethod is a method served over gRPC-Web and Connect at
+// /<service>/<method>, as native gRPC clients call it.
+type rpcMethod struct {
+	fullMethod string
+	desc       protoreflect.MethodDescriptor
+	input      protoreflect.MessageType
+	output     protoreflect.MessageType
+}
+
+func bindMethods(services []protoreflect.ServiceDescriptor) (map[string]rpcMethod, error) {
+	methods := make(map[string]rpcMethod)
+	for _, service := range services {
+		for i := 0; i < service.Methods().Len(); i++ {
+			desc := service.Methods().Get(i)
+			input, err := protoregistry.GlobalTypes.FindMessageByName(desc.Input().FullName())
+			if err != nil {
+				return nil, err
+			}
+			output, err := protoregistry.GlobalTypes.FindMessageByName(desc.Output().FullName())
+			if err != nil {
+				return nil, err
+			}
+			fullMethod := "/" + string(service.FullName()) + "/" + string(desc.Name())
+			methods[fullMethod] = rpcMethod{fullMethod: fullMethod, desc: desc, input: input, output: output}
+		}
+	}
+	return methods, nil
+}
+
+// codec encodes the messages of a gRPC-Web or Connect call, as named by the
+// suffix of the content type.
+type codec struct {
+	name      string
+	marshal   func(proto.Message) ([]byte, error)
+	unmarshal func([]byte, proto.Message) error
+}
+
+var (
+	protoCodec = codec{"proto", proto.Marshal, proto.Unmarshal}
+	jsonCodec  = codec{"json", protojson.Marshal, protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal}
+)
+
+func codecNamed(name string) (codec, bool) {
+	switch name {
+	case "proto":
+		return protoCodec, true
+	case "json":
+		return jsonCodec, true
+	}
+	return codec{}, false
+}
+
+// Request headers that describe the HTTP exchange rather than the call, and
+// are therefore not forwarded as metadata.
+var httpHeaders = map[string]bool{
+	"accept": true, "accept-encoding": true, "accept-language": true, "connection": true,
+	"content-encoding": true, "content-length": true, "content-type": true, "cookie": true,
+	"host": true, "keep-alive": true, "origin": true, "referer": true, "te": true,
+	"trailer": true, "transfer-encoding": true, "upgrade": true, "user-agent": true,
+	"x-grpc-web": true, "x-user-agent": true,
+}
+
+// outgoingContext returns ctx carrying the request headers as metadata, with
+// binary -bin values decoded from base64. Caller headers are dropped unless
+// the gateway trusts them.
+func (g *Gateway) outgoingContext(ctx context.Context, header http.Header) (context.Context, error) {
+	md := metadata.MD{}
+	for key, values := range header {
+		key = strings.ToLower(key)
+		if (!g.trustCallers && isCallerHeader(key)) || httpHeaders[key] || strings.HasPrefix(key, "grpc-") || strings.HasPrefix(key, "connect-") ||
+			strings.HasPrefix(key, "sec-") || strings.HasPrefix(key, "access-control-") || strings.HasPrefix(key, "proxy-") {
+			continue
+		}
+		for _, value := range values {
+			if strings.HasSuffix(key, "-bin") {
+				decoded, err := decodeBinaryHeader(value)
+				if err != nil {
+					return nil, fmt.Errorf("header %s: %v", key, err)
+				}
+				value = string(decoded)
+			}
+			md.Append(key, value)
+		}
+	}
+	return metadata.NewOutgoingContext(ctx, md), nil
+}
+
+func decodeBinaryHeader(value string) ([]byte, error) {
+	if len(value)%4 == 0 {
+		return base64.StdEncoding.DecodeString(value)
+	}
+	return base64.RawStdEncoding.DecodeString(value)
+}
+
+// setMetadataHeaders adds md to header, prefixing the keys with prefix and
+// encoding binary values in base64.
+func isCallerHeader(key string) bool {
+	for _, header := range callerHeaders {
+		if key == header {
+			return true
+		}
+	}
+	return false
+}
+
+func setMetadataHeaders(header http.Header, md metadata.MD, prefix string) {
+	for key, values := range md {
+		if key == "content-type" || strings.HasPrefix(key, "grpc-") || strings.HasPrefix(key, ":") {
+			continue
+		}
+		for _, value := range values {
+			if strings.HasSuffix(key, "-bin") {
+				value = base64.StdEncoding.EncodeToString([]byte(value))
+			}
+			header.Add(prefix+key, value)
+		}
+	}
+}
+
+// withTimeout applies the timeout of the call, if any, to ctx.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// parseGRPCTimeout parses a grpc-timeout header such as "1500m".
+func parseGRPCTimeout(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	units := map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second, 'm': time.Millisecond, 'u': time.Microsecond, 'n': time.Nanosecond}
+	unit, ok := units[value[len(value)-1]]
+	amount, err := strconv.ParseInt(value[:len(value)-1], 10, 64)
+	if !ok || err != nil || amount < 0 || len(value) > 9 {
+		return 0, fmt.Errorf("invalid grpc-timeout %q", value)
+	}
+	return time.Duration(amount) * unit, nil
+}
+
+// Flags of the envelopes framing streamed messages.
+const (
+	flagCompressed   = 0x01
+	flagEndStream    = 0x02 // Connect end of stream
+	flagGRPCWebTrail = 0x80 // gRPC-Web trailers
+)
+
+type envelope struct {
+	flags byte
+	data  []byte
+}
+
+// readEnvelopes reads the length-prefixed messages making up a request body.
+func readEnvelopes(r io.Reader) ([]envelope, error) {
+	var envelopes []envelope
+	for {
+		var prefix [5]byte
+		if _, err := io.ReadFull(r, prefix[:]); err == io.EOF {
+			return envelopes, nil
+		} else if err != nil {
+			return nil, readError(err)
+		}
+		size := binary.BigEndian.Uint32(prefix[1:])
+		if size > maxMessageSize {
+			return nil, fmt.Errorf("message of %d bytes exceeds the limit of %d", size, maxMessageSize)
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, readError(err)
+		}
+		envelopes = append(envelopes, envelope{flags: prefix[0], data: data})
+	}
+}
+
+func readError(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return fmt.Errorf("truncated message")
+	}
+	return fmt.Errorf("failed to read body: %v", err)
+}
+
+func writeEnvelope(w io.Writer, flags byte, data []byte) error {
+	var prefix [5]byte
+	prefix[0] = flags
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(data)))
+	if _, err := w.Write(prefix[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// decodeRequests decodes the request envelopes, checking that unary methods
+// get exactly one message.
+func decodeRequests(method rpcMethod, envelopes []envelope, c codec) ([]proto.Message, error) {
+	if !method.desc.IsStreamingClient() && len(envelopes) != 1 {
+		return nil, fmt.Errorf("%s expects exactly one request message, got %d", method.desc.Name(), len(envelopes))
+	}
+	var requests []proto.Message
+	for _, e := range envelopes {
+		if e.flags&flagCompressed != 0 {
+			return nil, fmt.Errorf("compressed messages are not supported")
+		}
+		msg := method.input.New().Interface()
+		if err := c.unmarshal(e.data, msg); err != nil {
+			return nil, fmt.Errorf("invalid request message: %v", err)
+		}
+		requests = append(requests, msg)
+	}
+	return requests, nil
+}
+
+// openStream starts method on the gRPC server and sends it every request, so
+// that the responses can be read from the returned stream.
+func (g *Gateway) openStream(ctx context.Context, method rpcMethod, requests []proto.Message) (grpc.ClientStream, error) {
+	desc := &grpc.StreamDesc{
+		StreamName:    string(method.desc.Name()),
+		ServerStreams: method.desc.IsStreamingServer(),
+		ClientStreams: method.desc.IsStreamingClient(),
+	}
+	stream, err := g.conn.NewStream(ctx, desc, method.fullMethod)
+	if err != nil {
+		return nil, err
+	}
+	for _, req := range requests {
+		// On failure the status of the call is returned by RecvMsg
+		if err := stream.SendMsg(req); err != nil {
+			break
+		}
+	}
+	stream.CloseSend()
+	return stream, nil
+}
+
+// marshalStatus encodes st with its details, as sent in
+// grpc-status-details-bin.
+func marshalStatus(st *status.Status) ([]byte, error) {
+	return proto.Marshal(st.Proto())
+}
+
+// statusOf converts the error ending a stream, io.EOF meaning success.
+func statusOf(err error) *status.Status {
+	if err == io.EOF {
+		return status.New(codes.OK, "")
+	}
+	return status.Convert(err)
+}
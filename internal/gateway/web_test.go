@@ -0,0 +1,418 @@
+package gateway_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"server/internal/api"
+	"server/internal/gateway"
+	"server/internal/handlers/schema"
+	"server/internal/providers/idempotency"
+	"server/internal/providers/storage"
+	schema_service "server/proto"
+	schema_service_v2 "server/proto/v2"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const editorOrigin = "https://editor.example"
+
+// newWebServer serves the whole service, from the gateway down to a storage
+// loaded from the storage test data, to an in-process HTTP client.
+func newWebServer(t *testing.T) *httptest.Server {
+	storageService, err := storage.NewStorage("../providers/storage/test_storage.json", true)
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			api.RequestInfoUnaryInterceptor(false),
+			api.ErrorUnaryInterceptor,
+			api.ValidationUnaryInterceptor,
+			api.IdempotencyUnaryInterceptor(idempotency.NewStore(time.Hour)),
+		),
+		grpc.ChainStreamInterceptor(api.RequestInfoStreamInterceptor(false), api.ErrorStreamInterceptor, api.ValidationStreamInterceptor),
+	)
+	handler := &schema.Schema{StorageProvider: storageService}
+	schema_service.RegisterSchemaServiceServer(server, &api.SchemaServer{SchemaHandler: handler})
+	schema_service_v2.RegisterSchemaServiceServer(server, &api.SchemaServerV2{SchemaHandler: handler})
+
+	lis := bufconn.Listen(1 << 20)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	g, err := gateway.New(conn, false)
+	if err != nil {
+		t.Fatalf("Failed to create gateway: %v", err)
+	}
+	httpServer := httptest.NewServer(gateway.WithCORS(g, []string{editorOrigin}))
+	t.Cleanup(httpServer.Close)
+	return httpServer
+}
+
+func post(t *testing.T, server *httptest.Server, path string, contentType string, body []byte, header http.Header) (*http.Response, []byte) {
+	req, err := http.NewRequest(http.MethodPost, server.URL+path, bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Failed to read response: %v", err)
+	}
+	return resp, data
+}
+
+func envelope(flags byte, data []byte) []byte {
+	framed := make([]byte, 5, 5+len(data))
+	framed[0] = flags
+	binary.BigEndian.PutUint32(framed[1:], uint32(len(data)))
+	return append(framed, data...)
+}
+
+func envelopes(t *testing.T, messages ...proto.Message) []byte {
+	var body []byte
+	for _, msg := range messages {
+		data, err := proto.Marshal(msg)
+		if err != nil {
+			t.Fatalf("Failed to marshal: %v", err)
+		}
+		body = append(body, envelope(0, data)...)
+	}
+	return body
+}
+
+type frame struct {
+	flags byte
+	data  []byte
+}
+
+func splitFrames(t *testing.T, body []byte) []frame {
+	var frames []frame
+	for len(body) > 0 {
+		if len(body) < 5 {
+			t.Fatalf("Truncated frame in %q", body)
+		}
+		size := binary.BigEndian.Uint32(body[1:5])
+		frames = append(frames, frame{flags: body[0], data: body[5 : 5+size]})
+		body = body[5+size:]
+	}
+	return frames
+}
+
+const servicePath = "/alt_team.schema_service.SchemaService/"
+
+func TestConnect(t *testing.T) {
+	server := newWebServer(t)
+
+	t.Run("UnaryJSON", func(t *testing.T) {
+		body := `{"authorId": "editor", "schemaName": "fromConnect", "tasks": [{"id": 1, "name": "Triage", "level": 1, "status": "TASK_STATUS_NOT_STARTED"}]}`
+		resp, data := post(t, server, servicePath+"CreateSchema", "application/json", []byte(body), http.Header{"Connect-Protocol-Version": {"1"}})
+
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "application/json" {
+			t.Fatalf("Expected 200 JSON, got %d %s", resp.StatusCode, data)
+		}
+		created := &schema_service.CreateSchemaResponse{}
+		if err := protojson.Unmarshal(data, created); err != nil || created.Schema.SchemaName != "fromConnect" {
+			t.Errorf("Unexpected response %s (%v)", data, err)
+		}
+		if resp.Header.Get("X-Request-Id") == "" {
+			t.Errorf("Expected a request id header")
+		}
+	})
+
+	t.Run("UnaryProto", func(t *testing.T) {
+		request, _ := proto.Marshal(&schema_service.GetSchemaByIDRequest{SchemaId: "0abd659f-8e41-4e72-9c6e-170be7745b00"})
+		resp, data := post(t, server, servicePath+"GetSchemaByID", "application/proto", request, nil)
+
+		found := &schema_service.GetSchemaByIDResponse{}
+		if resp.StatusCode != http.StatusOK || proto.Unmarshal(data, found) != nil || found.Schema.SchemaName != "Schema1" {
+			t.Errorf("Expected Schema1, got %d %x", resp.StatusCode, data)
+		}
+	})
+
+	t.Run("UnaryError", func(t *testing.T) {
+		resp, data := post(t, server, servicePath+"GetSchemaByID", "application/json", []byte(`{"schemaId": "missing"}`), nil)
+
+		var connectErr struct {
+			Code    string `json:"code"`
+			Details []struct {
+				Type  string `json:"type"`
+				Value string `json:"value"`
+			} `json:"details"`
+		}
+		if err := json.Unmarshal(data, &connectErr); err != nil || resp.StatusCode != http.StatusNotFound || connectErr.Code != "not_found" {
+			t.Fatalf("Expected 404 not_found, got %d %s", resp.StatusCode, data)
+		}
+		value, _ := base64.RawStdEncoding.DecodeString(connectErr.Details[0].Value)
+		info := &errdetails.ErrorInfo{}
+		if connectErr.Details[0].Type != "google.rpc.ErrorInfo" || proto.Unmarshal(value, info) != nil || info.Reason != "SCHEMA_NOT_FOUND" {
+			t.Errorf("Unexpected details %s", data)
+		}
+	})
+
+	t.Run("ServerStream", func(t *testing.T) {
+		body := envelopes(t, &schema_service.ExportSchemasRequest{SchemaNamePrefix: "Schema"})
+		resp, data := post(t, server, servicePath+"ExportSchemas", "application/connect+proto", body, nil)
+
+		frames := splitFrames(t, data)
+		if resp.StatusCode != http.StatusOK || len(frames) != 5 {
+			t.Fatalf("Expected the snapshot, 3 schemas and the end of stream, got %d %d frames", resp.StatusCode, len(frames))
+		}
+		exported := &schema_service.ExportSchemasResponse{}
+		if proto.Unmarshal(frames[0].data, exported) != nil || exported.Total != 3 || exported.Schema != nil {
+			t.Errorf("Unexpected message %+v", exported)
+		}
+		if frames[4].flags != 0x02 || string(frames[4].data) != "{}" {
+			t.Errorf("Expected a successful end of stream, got %x %s", frames[4].flags, frames[4].data)
+		}
+	})
+
+	t.Run("ClientStream", func(t *testing.T) {
+		body := append(
+			envelope(0, []byte(`{"options": {"conflictPolicy": "IMPORT_CONFLICT_POLICY_RENAME"}}`)),
+			envelope(0, []byte(`{"schema": {"authorId": "editor", "schemaName": "Schema1"}}`))...,
+		)
+		resp, data := post(t, server, servicePath+"ImportSchemas", "application/connect+json", body, nil)
+
+		frames := splitFrames(t, data)
+		if resp.StatusCode != http.StatusOK || len(frames) != 2 {
+			t.Fatalf("Expected the response and the end of stream, got %d %s", resp.StatusCode, data)
+		}
+		imported := &schema_service.ImportSchemasResponse{}
+		if err := protojson.Unmarshal(frames[0].data, imported); err != nil || imported.Summary.Renamed != 1 || imported.Results[0].SchemaName != "Schema1 (2)" {
+			t.Errorf("Unexpected response %s (%v)", frames[0].data, err)
+		}
+	})
+
+	t.Run("StreamError", func(t *testing.T) {
+		body := envelopes(t, &schema_service.WatchSchemasRequest{FromRevision: 1000})
+		resp, data := post(t, server, servicePath+"WatchSchemas", "application/connect+proto", body, nil)
+
+		frames := splitFrames(t, data)
+		if resp.StatusCode != http.StatusOK || len(frames) != 1 || !strings.Contains(string(frames[0].data), `"code":"failed_precondition"`) {
+			t.Errorf("Expected an end of stream with the error, got %d %s", resp.StatusCode, data)
+		}
+	})
+
+	t.Run("V2", func(t *testing.T) {
+		resp, data := post(t, server, "/alt_team.schema_service.v2.SchemaService/GetSchema", "application/json", []byte(`{"schemaId": "0abd659f-8e41-4e72-9c6e-170be7745b00"}`), nil)
+
+		found := &schema_service_v2.Schema{}
+		if err := protojson.Unmarshal(data, found); err != nil || resp.StatusCode != http.StatusOK || found.Metadata.Revision == 0 {
+			t.Errorf("Expected Schema1 with its metadata, got %d %s", resp.StatusCode, data)
+		}
+	})
+
+	t.Run("UnsupportedContentType", func(t *testing.T) {
+		resp, _ := post(t, server, servicePath+"ExportSchemas", "application/json", []byte(`{}`), nil)
+
+		if resp.StatusCode != http.StatusUnsupportedMediaType {
+			t.Errorf("Expected 415 for a unary content type on a stream, got %d", resp.StatusCode)
+		}
+	})
+}
+
+// grpcWebTrailers parses the trailers frame ending a gRPC-Web response.
+func grpcWebTrailers(t *testing.T, f frame) map[string]string {
+	if f.flags != 0x80 {
+		t.Fatalf("Expected a trailers frame, got flags %x", f.flags)
+	}
+	trailers := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(string(f.data)), "\r\n") {
+		key, value, _ := strings.Cut(line, ": ")
+		trailers[key] = value
+	}
+	return trailers
+}
+
+func TestGRPCWeb(t *testing.T) {
+	server := newWebServer(t)
+
+	t.Run("Unary", func(t *testing.T) {
+		body := envelopes(t, &schema_service.GetAllSchemasRequest{})
+		resp, data := post(t, server, servicePath+"GetAllSchemas", "application/grpc-web+proto", body, http.Header{"X-Request-Id": {"web-1"}})
+
+		frames := splitFrames(t, data)
+		if resp.StatusCode != http.StatusOK || len(frames) != 2 {
+			t.Fatalf("Expected a message and the trailers, got %d %d frames", resp.StatusCode, len(frames))
+		}
+		all := &schema_service.GetAllSchemasResponse{}
+		if proto.Unmarshal(frames[0].data, all) != nil || len(all.Schemas) != 3 {
+			t.Errorf("Expected 3 schemas, got %+v", all)
+		}
+		if trailers := grpcWebTrailers(t, frames[1]); trailers["grpc-status"] != "0" {
+			t.Errorf("Expected OK, got %v", trailers)
+		}
+		if resp.Header.Get("X-Request-Id") != "web-1" {
+			t.Errorf("Expected the request id to be returned, got %q", resp.Header.Get("X-Request-Id"))
+		}
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		body := base64.StdEncoding.EncodeToString(envelopes(t, &schema_service.GetSchemaByIDRequest{SchemaId: "dd19b4f7-a4be-4ec8-a48b-be6c4f769b4b"}))
+		resp, data := post(t, server, servicePath+"GetSchemaByID", "application/grpc-web-text", []byte(body), nil)
+
+		if resp.Header.Get("Content-Type") != "application/grpc-web-text+proto" {
+			t.Errorf("Unexpected content type %q", resp.Header.Get("Content-Type"))
+		}
+		var decoded []byte
+		for _, chunk := range strings.SplitAfter(string(data), "=") {
+			if chunk = strings.TrimLeft(chunk, "="); chunk != "" {
+				part, err := base64.StdEncoding.DecodeString(chunk + strings.Repeat("=", (4-len(chunk)%4)%4))
+				if err != nil {
+					t.Fatalf("Invalid base64 response %q: %v", data, err)
+				}
+				decoded = append(decoded, part...)
+			}
+		}
+		frames := splitFrames(t, decoded)
+		found := &schema_service.GetSchemaByIDResponse{}
+		if len(frames) != 2 || proto.Unmarshal(frames[0].data, found) != nil || found.Schema.SchemaName != "Schema2" {
+			t.Errorf("Expected Schema2, got %d frames", len(frames))
+		}
+	})
+
+	t.Run("ValidationError", func(t *testing.T) {
+		body := envelopes(t, &schema_service.CreateSchemaRequest{AuthorId: "editor"})
+		resp, data := post(t, server, servicePath+"CreateSchema", "application/grpc-web+proto", body, nil)
+
+		frames := splitFrames(t, data)
+		trailers := grpcWebTrailers(t, frames[len(frames)-1])
+		if resp.StatusCode != http.StatusOK || len(frames) != 1 || trailers["grpc-status"] != "3" {
+			t.Fatalf("Expected INVALID_ARGUMENT trailers only, got %d %v", resp.StatusCode, trailers)
+		}
+		details, _ := base64.StdEncoding.DecodeString(trailers["grpc-status-details-bin"])
+		st := &spb.Status{}
+		if proto.Unmarshal(details, st) != nil || st.Code != int32(codes.InvalidArgument) || len(st.Details) == 0 {
+			t.Errorf("Expected the status details, got %v", trailers)
+		}
+	})
+
+	t.Run("ServerStream", func(t *testing.T) {
+		body := envelopes(t, &schema_service.ExportSchemasRequest{AuthorIds: []string{"Author1"}})
+		_, data := post(t, server, servicePath+"ExportSchemas", "application/grpc-web+proto", body, nil)
+
+		frames := splitFrames(t, data)
+		if len(frames) != 4 || grpcWebTrailers(t, frames[3])["grpc-status"] != "0" {
+			t.Errorf("Expected the snapshot, 2 schemas of Author1 and the trailers, got %d frames", len(frames))
+		}
+	})
+}
+
+func TestCORS(t *testing.T) {
+	server := newWebServer(t)
+
+	preflight := func(origin, path, method string) *http.Response {
+		req, _ := http.NewRequest(http.MethodOptions, server.URL+path, nil)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", method)
+		req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+		resp, err := server.Client().Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	t.Run("PreflightAllowed", func(t *testing.T) {
+		resp := preflight(editorOrigin, servicePath+"CreateSchema", http.MethodPost)
+
+		if resp.StatusCode != http.StatusNoContent || resp.Header.Get("Access-Control-Allow-Origin") != editorOrigin {
+			t.Errorf("Expected the origin to be allowed, got %d %v", resp.StatusCode, resp.Header)
+		}
+		if !strings.Contains(resp.Header.Get("Access-Control-Allow-Headers"), "X-Grpc-Web") {
+			t.Errorf("Expected gRPC-Web headers to be allowed, got %q", resp.Header.Get("Access-Control-Allow-Headers"))
+		}
+	})
+
+	t.Run("PreflightPatch", func(t *testing.T) {
+		resp := preflight(editorOrigin, "/v1/schemas/schema-id", http.MethodPatch)
+
+		if resp.StatusCode != http.StatusNoContent || !strings.Contains(resp.Header.Get("Access-Control-Allow-Methods"), http.MethodPatch) {
+			t.Errorf("Expected PATCH to be allowed, got %d %v", resp.StatusCode, resp.Header)
+		}
+	})
+
+	t.Run("PreflightRefused", func(t *testing.T) {
+		resp := preflight("https://elsewhere.example", servicePath+"CreateSchema", http.MethodPost)
+
+		if resp.Header.Get("Access-Control-Allow-Origin") != "" {
+			t.Errorf("Expected no CORS headers, got %v", resp.Header)
+		}
+	})
+
+	t.Run("ExposesHeaders", func(t *testing.T) {
+		body := envelopes(t, &schema_service.GetAllSchemasRequest{})
+		resp, _ := post(t, server, servicePath+"GetAllSchemas", "application/grpc-web+proto", body, http.Header{"Origin": {editorOrigin}})
+
+		if resp.Header.Get("Access-Control-Allow-Origin") != editorOrigin || !strings.Contains(resp.Header.Get("Access-Control-Expose-Headers"), "Grpc-Status") {
+			t.Errorf("Expected CORS headers, got %v", resp.Header)
+		}
+	})
+}
+
+func TestSpoofedCaller(t *testing.T) {
+	server := newWebServer(t)
+	spoofed := http.Header{"X-Caller-Id": {"mallory"}, "X-Caller-Roles": {"pharmacist,medical_lead"}}
+
+	resp, data := post(t, server, "/v1/schemas", "application/json", []byte(`{"author_id": "editor", "schema_name": "spoofed review", "tasks": []}`), spoofed)
+	created := &schema_service.CreateSchemaResponse{}
+	if resp.StatusCode != http.StatusOK || protojson.Unmarshal(data, created) != nil {
+		t.Fatalf("Failed to create schema: %d %s", resp.StatusCode, data)
+	}
+	id := created.Schema.SchemaId
+	if resp, data := post(t, server, "/v1/schemas/"+id+":requestReview", "application/json", []byte(`{}`), nil); resp.StatusCode != http.StatusOK {
+		t.Fatalf("Failed to request review: %d %s", resp.StatusCode, data)
+	}
+
+	t.Run("REST", func(t *testing.T) {
+		resp, data := post(t, server, "/v1/schemas/"+id+":approve", "application/json", []byte(`{}`), spoofed)
+
+		if resp.StatusCode != http.StatusForbidden || !strings.Contains(string(data), "ANONYMOUS_REVIEWER") {
+			t.Errorf("Expected the spoofed caller to be anonymous, got %d %s", resp.StatusCode, data)
+		}
+	})
+
+	t.Run("Connect", func(t *testing.T) {
+		resp, data := post(t, server, servicePath+"ApproveSchema", "application/json", []byte(`{"schemaId": "`+id+`"}`), spoofed)
+
+		if resp.StatusCode != http.StatusForbidden || !strings.Contains(string(data), "permission_denied") {
+			t.Errorf("Expected the spoofed caller to be anonymous, got %d %s", resp.StatusCode, data)
+		}
+	})
+}
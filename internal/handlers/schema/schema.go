@@ -1,69 +1,608 @@
 package schema
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"server/internal/domain"
+	"strings"
 )
 
 type StorageInterface interface {
-	CreateSchema(authorID string, schemaName string, tasks []domain.Task) (domain.Schema, error)
-	GetAllSchemas() ([]domain.Schema, error)
-	GetSchemaByID(id string) (domain.Schema, error)
-	DeleteSchemaByID(id string) error
+	CreateSchema(ctx context.Context, authorID string, schemaName string, tasks []domain.Task) (domain.Schema, error)
+	GetAllSchemas(ctx context.Context) ([]domain.Schema, error)
+	GetSchemaByID(ctx context.Context, id string) (domain.Schema, error)
+	DeleteSchemaByID(ctx context.Context, id string) error
+	SearchSchemas(ctx context.Context, query string, limit int) ([]domain.SearchResult, error)
+	GetSchemasByIDs(ctx context.Context, ids []string) ([]domain.SchemaResult, error)
+	DeleteSchemasByIDs(ctx context.Context, ids []string) ([]domain.SchemaResult, error)
+	WatchSchemas(ctx context.Context, fromRevision int64, filter domain.EventFilter) (domain.EventStream, error)
+	ExportSchemas(ctx context.Context, filter domain.ExportFilter) (domain.Snapshot, error)
+	GetChangesSince(ctx context.Context, revision int64, limit int) (domain.ChangeSet, error)
+	CloneSchema(ctx context.Context, sourceID string, authorID string, schemaName string, renumberTasks bool) (domain.Schema, error)
+	GetDerivedSchemas(ctx context.Context, id string, transitive bool) ([]domain.Schema, error)
+	ImportSchemas(ctx context.Context, items []domain.ImportItem, policy domain.ConflictPolicy) ([]domain.ImportResult, error)
+	GetSchemaByName(ctx context.Context, name string) (domain.Schema, error)
+	GetSchemasByAuthor(ctx context.Context, authorID string) ([]domain.Schema, error)
+	GetSchemaBySlug(ctx context.Context, slug string) (domain.Schema, bool, error)
+	RenameSchema(ctx context.Context, id string, name string) (domain.Schema, error)
+	UpdateSchema(ctx context.Context, id string, tasks []domain.Task, assignTaskIDs bool) (domain.Schema, error)
+	PublishSchema(ctx context.Context, id string) (domain.SchemaVersion, error)
+	GetSchemaVersions(ctx context.Context, id string) ([]domain.SchemaVersion, error)
+	GetSchemaVersion(ctx context.Context, id string, version int64) (domain.SchemaVersion, error)
+	TransitionSchema(ctx context.Context, id string, state domain.SchemaState, comment string) (domain.Schema, error)
+	ReviewSchema(ctx context.Context, id string, decision domain.ReviewDecision, comment string) (domain.Schema, error)
 }
 
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	maxBatchSize       = 1000
+	maxChangesLimit    = 1000
+)
+
 type Schema struct {
 	StorageProvider StorageInterface
 }
 
-func (s *Schema) Create(authorID string, schemaName string, tasks []domain.Task) (domain.Schema, error) {
+// classify makes sure every error leaving the handler wraps one of the
+// domain error kinds or tells that the call was given up, treating anything
+// unexpected from storage as internal.
+func classify(err error) error {
+	var domainErr *domain.Error
+	if err == nil || errors.As(err, &domainErr) {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	return domain.InternalError("STORAGE_ERROR", "%v", err)
+}
+
+func (s *Schema) Create(ctx context.Context, authorID string, schemaName string, tasks []domain.Task, assignTaskIDs bool) (domain.Schema, error) {
 	fmt.Println("START Schema.Create handler")
 
+	tasks, err := prepareTasks(tasks, assignTaskIDs)
+	if err != nil {
+		return domain.Schema{}, err
+	}
+	if assignTaskIDs {
+		domain.AssignTaskIDs(tasks)
+	}
+
 	// Forward creation to Storage
-	schema, err := s.StorageProvider.CreateSchema(authorID, schemaName, tasks)
+	schema, err := s.StorageProvider.CreateSchema(ctx, authorID, schemaName, tasks)
 	if err != nil {
 		fmt.Println("Error creating Schema: ", err)
 	}
 
 	fmt.Println("END Schema.Create handler")
-	return schema, err
+	return schema, classify(err)
+}
+
+// prepareTasks checks the tasks sent for a schema and returns the copy to
+// store, with the levels left out filled in. With assignTaskIDs the ids only
+// link blocked_by, and the caller numbers the tasks.
+func prepareTasks(tasks []domain.Task, assignTaskIDs bool) ([]domain.Task, error) {
+	if err := domain.ValidateTaskIDs(tasks, assignTaskIDs); err != nil {
+		return nil, err
+	}
+
+	// A schema whose tasks block each other could never be run
+	if err := domain.ValidateTaskGraph(tasks); err != nil {
+		return nil, err
+	}
+
+	if err := domain.ValidateTaskLevels(tasks); err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateTaskStatuses(tasks); err != nil {
+		return nil, err
+	}
+
+	tasks = domain.CloneTasks(tasks)
+	domain.SetTaskLevels(tasks)
+	return tasks, nil
 }
 
-func (s *Schema) GetAll() ([]domain.Schema, error) {
+func (s *Schema) GetAll(ctx context.Context, states []domain.SchemaState) ([]domain.Schema, error) {
 	fmt.Println("START Schema.GetAll handler")
 
 	// Forward fetch to Storage
-	schemas, err := s.StorageProvider.GetAllSchemas()
+	schemas, err := s.StorageProvider.GetAllSchemas(ctx)
 	if err != nil {
 		fmt.Printf("Error getting all Schemas: %s\n", err)
 	}
 
 	fmt.Println("END Schema.GetAll handler")
-	return schemas, err
+	return inStates(schemas, states), classify(err)
+}
+
+// inStates keeps the schemas in one of states, or all of them without
+// states.
+func inStates(schemas []domain.Schema, states []domain.SchemaState) []domain.Schema {
+	if len(states) == 0 {
+		return schemas
+	}
+	var matching []domain.Schema
+	for _, schema := range schemas {
+		if schema.InStates(states) {
+			matching = append(matching, schema)
+		}
+	}
+	return matching
+}
+
+func (s *Schema) Export(ctx context.Context, filter domain.ExportFilter) (domain.Snapshot, error) {
+	fmt.Println("START Schema.Export handler")
+
+	// Forward snapshot to Storage
+	snapshot, err := s.StorageProvider.ExportSchemas(ctx, filter)
+	if err != nil {
+		fmt.Printf("Error exporting Schemas: %s\n", err)
+	}
+
+	fmt.Println("END Schema.Export handler")
+	return snapshot, classify(err)
 }
 
-func (s *Schema) GetByID(id string) (domain.Schema, error) {
+func (s *Schema) GetByID(ctx context.Context, id string) (domain.Schema, error) {
 	fmt.Println("START Schema.GetByID handler")
 
 	// Forward fetch to Storage
-	schema, err := s.StorageProvider.GetSchemaByID(id)
+	schema, err := s.StorageProvider.GetSchemaByID(ctx, id)
 	if err != nil {
 		fmt.Printf("Error getting Schema with id=<%s>: %s\n", id, err)
 	}
 
 	fmt.Println("END Schema.GetByID handler")
-	return schema, err
+	return schema, classify(err)
+}
+
+func (s *Schema) GetByName(ctx context.Context, name string) (domain.Schema, error) {
+	fmt.Println("START Schema.GetByName handler")
+
+	// Forward fetch to Storage
+	schema, err := s.StorageProvider.GetSchemaByName(ctx, name)
+	if err != nil {
+		fmt.Printf("Error getting Schema with name=<%s>: %s\n", name, err)
+	}
+
+	fmt.Println("END Schema.GetByName handler")
+	return schema, classify(err)
+}
+
+func (s *Schema) ListByAuthor(ctx context.Context, authorID string, states []domain.SchemaState) ([]domain.Schema, error) {
+	fmt.Println("START Schema.ListByAuthor handler")
+
+	// Forward fetch to Storage
+	schemas, err := s.StorageProvider.GetSchemasByAuthor(ctx, authorID)
+	if err != nil {
+		fmt.Printf("Error getting Schemas of author=<%s>: %s\n", authorID, err)
+	}
+
+	fmt.Println("END Schema.ListByAuthor handler")
+	return inStates(schemas, states), classify(err)
 }
 
-func (s *Schema) DeleteByID(id string) error {
+// GetBySlug resolves a slug, ignoring its case, to the schema using it or
+// that used it before being renamed; alias tells the latter.
+func (s *Schema) GetBySlug(ctx context.Context, slug string) (schema domain.Schema, alias bool, err error) {
+	fmt.Println("START Schema.GetBySlug handler")
+
+	// Forward fetch to Storage
+	schema, alias, err = s.StorageProvider.GetSchemaBySlug(ctx, strings.ToLower(slug))
+	if err != nil {
+		fmt.Printf("Error getting Schema with slug=<%s>: %s\n", slug, err)
+	}
+
+	fmt.Println("END Schema.GetBySlug handler")
+	return schema, alias, classify(err)
+}
+
+func (s *Schema) Rename(ctx context.Context, id string, name string) (domain.Schema, error) {
+	fmt.Println("START Schema.Rename handler")
+
+	// Forward renaming to Storage
+	schema, err := s.StorageProvider.RenameSchema(ctx, id, name)
+	if err != nil {
+		fmt.Printf("Error renaming Schema with id=<%s>: %s\n", id, err)
+	}
+
+	fmt.Println("END Schema.Rename handler")
+	return schema, classify(err)
+}
+
+func (s *Schema) Update(ctx context.Context, id string, tasks []domain.Task, assignTaskIDs bool) (domain.Schema, error) {
+	fmt.Println("START Schema.Update handler")
+
+	tasks, err := prepareTasks(tasks, assignTaskIDs)
+	if err != nil {
+		return domain.Schema{}, err
+	}
+
+	// Forward the update of the draft to Storage
+	schema, err := s.StorageProvider.UpdateSchema(ctx, id, tasks, assignTaskIDs)
+	if err != nil {
+		fmt.Printf("Error updating Schema with id=<%s>: %s\n", id, err)
+	}
+
+	fmt.Println("END Schema.Update handler")
+	return schema, classify(err)
+}
+
+func (s *Schema) Publish(ctx context.Context, id string) (domain.SchemaVersion, error) {
+	fmt.Println("START Schema.Publish handler")
+
+	// Forward publishing to Storage
+	version, err := s.StorageProvider.PublishSchema(ctx, id)
+	if err != nil {
+		fmt.Printf("Error publishing Schema with id=<%s>: %s\n", id, err)
+	}
+
+	fmt.Println("END Schema.Publish handler")
+	return version, classify(err)
+}
+
+func (s *Schema) ListVersions(ctx context.Context, id string) ([]domain.SchemaVersion, error) {
+	fmt.Println("START Schema.ListVersions handler")
+
+	// Get the published versions from Storage
+	versions, err := s.StorageProvider.GetSchemaVersions(ctx, id)
+	if err != nil {
+		fmt.Printf("Error getting versions of Schema with id=<%s>: %s\n", id, err)
+	}
+
+	fmt.Println("END Schema.ListVersions handler")
+	return versions, classify(err)
+}
+
+func (s *Schema) GetVersion(ctx context.Context, id string, version int64) (domain.SchemaVersion, error) {
+	fmt.Println("START Schema.GetVersion handler")
+
+	// Get the published version from Storage
+	schemaVersion, err := s.StorageProvider.GetSchemaVersion(ctx, id, version)
+	if err != nil {
+		fmt.Printf("Error getting version %d of Schema with id=<%s>: %s\n", version, id, err)
+	}
+
+	fmt.Println("END Schema.GetVersion handler")
+	return schemaVersion, classify(err)
+}
+
+func (s *Schema) Transition(ctx context.Context, id string, state domain.SchemaState, comment string) (domain.Schema, error) {
+	fmt.Println("START Schema.Transition handler")
+
+	// Forward the transition to Storage
+	schema, err := s.StorageProvider.TransitionSchema(ctx, id, state, comment)
+	if err != nil {
+		fmt.Printf("Error moving Schema with id=<%s> to %s: %s\n", id, state, err)
+	}
+
+	fmt.Println("END Schema.Transition handler")
+	return schema, classify(err)
+}
+
+// RequestReview moves a draft to IN_REVIEW, opening a review under the
+// current policy.
+func (s *Schema) RequestReview(ctx context.Context, id string, comment string) (domain.Schema, error) {
+	fmt.Println("START Schema.RequestReview handler")
+
+	// Forward the transition to Storage
+	schema, err := s.StorageProvider.TransitionSchema(ctx, id, domain.StateInReview, comment)
+	if err != nil {
+		fmt.Printf("Error requesting review of Schema with id=<%s>: %s\n", id, err)
+	}
+
+	fmt.Println("END Schema.RequestReview handler")
+	return schema, classify(err)
+}
+
+func (s *Schema) Approve(ctx context.Context, id string, comment string) (domain.Schema, error) {
+	fmt.Println("START Schema.Approve handler")
+
+	// Forward the decision to Storage
+	schema, err := s.StorageProvider.ReviewSchema(ctx, id, domain.DecisionApprove, comment)
+	if err != nil {
+		fmt.Printf("Error approving Schema with id=<%s>: %s\n", id, err)
+	}
+
+	fmt.Println("END Schema.Approve handler")
+	return schema, classify(err)
+}
+
+func (s *Schema) Reject(ctx context.Context, id string, comment string) (domain.Schema, error) {
+	fmt.Println("START Schema.Reject handler")
+
+	// Forward the decision to Storage
+	schema, err := s.StorageProvider.ReviewSchema(ctx, id, domain.DecisionReject, comment)
+	if err != nil {
+		fmt.Printf("Error rejecting Schema with id=<%s>: %s\n", id, err)
+	}
+
+	fmt.Println("END Schema.Reject handler")
+	return schema, classify(err)
+}
+
+// Diff compares two schemas, each at a published version or at its draft
+// for version 0. An empty toID compares two states of the schema fromID.
+func (s *Schema) Diff(ctx context.Context, fromID string, fromVersion int64, toID string, toVersion int64) (domain.SchemaDiff, error) {
+	fmt.Println("START Schema.Diff handler")
+
+	if toID == "" {
+		toID = fromID
+	}
+	from, err := s.schemaAt(ctx, fromID, fromVersion)
+	if err != nil {
+		return domain.SchemaDiff{}, err
+	}
+	to, err := s.schemaAt(ctx, toID, toVersion)
+	if err != nil {
+		return domain.SchemaDiff{}, err
+	}
+
+	diff, err := domain.DiffSchemas(from, to)
+	if err != nil {
+		fmt.Printf("Error comparing Schemas with id=<%s> and id=<%s>: %s\n", fromID, toID, err)
+	}
+
+	fmt.Println("END Schema.Diff handler")
+	return diff, err
+}
+
+// schemaAt returns the schema with its draft replaced by the given published
+// version, or as is for version 0.
+func (s *Schema) schemaAt(ctx context.Context, id string, version int64) (domain.Schema, error) {
+	if version == 0 {
+		return s.GetByID(ctx, id)
+	}
+	schemaVersion, err := s.GetVersion(ctx, id, version)
+	if err != nil {
+		return domain.Schema{}, err
+	}
+	return domain.Schema{SchemaID: id, SchemaName: schemaVersion.SchemaName, Tasks: schemaVersion.Tasks}, nil
+}
+
+func (s *Schema) DeleteByID(ctx context.Context, id string) error {
 	fmt.Println("START Schema.DeleteByID handler")
 
 	// Forward deletion to Storage
-	err := s.StorageProvider.DeleteSchemaByID(id)
+	err := s.StorageProvider.DeleteSchemaByID(ctx, id)
 	if err != nil {
 		fmt.Printf("Error deleting Schema with id=<%s>: %s\n", id, err)
 	}
 
 	fmt.Println("END Schema.DeleteByID handler")
-	return err
+	return classify(err)
+}
+
+func (s *Schema) Clone(ctx context.Context, sourceID string, authorID string, schemaName string, renumberTasks bool) (domain.Schema, error) {
+	fmt.Println("START Schema.Clone handler")
+
+	// Forward cloning to Storage
+	schema, err := s.StorageProvider.CloneSchema(ctx, sourceID, authorID, schemaName, renumberTasks)
+	if err != nil {
+		fmt.Printf("Error cloning Schema with id=<%s>: %s\n", sourceID, err)
+	}
+
+	fmt.Println("END Schema.Clone handler")
+	return schema, classify(err)
+}
+
+func (s *Schema) ListDerived(ctx context.Context, id string, transitive bool) ([]domain.Schema, error) {
+	fmt.Println("START Schema.ListDerived handler")
+
+	// Forward fetch to Storage
+	schemas, err := s.StorageProvider.GetDerivedSchemas(ctx, id, transitive)
+	if err != nil {
+		fmt.Printf("Error getting Schemas derived from id=<%s>: %s\n", id, err)
+	}
+
+	fmt.Println("END Schema.ListDerived handler")
+	return schemas, classify(err)
+}
+
+func (s *Schema) Validate(ctx context.Context, authorID string, schemaName string, tasks []domain.Task) ([]domain.Diagnostic, error) {
+	fmt.Println("START Schema.Validate handler")
+
+	diagnostics := domain.ValidateSchema(domain.Schema{AuthorID: authorID, SchemaName: schemaName, Tasks: tasks})
+
+	// Check the name against the stored schemas, as creation would
+	schemas, err := s.StorageProvider.GetAllSchemas(ctx)
+	if err != nil {
+		fmt.Printf("Error getting all Schemas: %s\n", err)
+		return nil, classify(err)
+	}
+	for _, schema := range schemas {
+		if schema.SchemaName == schemaName {
+			diagnostics = append(diagnostics, domain.Diagnostic{
+				Severity: domain.SeverityError,
+				Code:     "SCHEMA_NAME_TAKEN",
+				Field:    "schema_name",
+				Message:  fmt.Sprintf("schema with name '%s' already exists", schemaName),
+			})
+			break
+		}
+	}
+
+	fmt.Println("END Schema.Validate handler")
+	return diagnostics, nil
+}
+
+// Import validates a batch of imported schemas and stores the valid ones
+// together. Invalid schemas are reported as such and do not stop the batch.
+// With ConflictFail a conflict fails the import with ErrAlreadyExists and no
+// schema of the batch is stored; the results tell which one conflicted.
+func (s *Schema) Import(ctx context.Context, items []domain.ImportItem, policy domain.ConflictPolicy) ([]domain.ImportResult, error) {
+	fmt.Println("START Schema.Import handler")
+
+	if len(items) > maxBatchSize {
+		return nil, domain.InvalidArgumentError("BATCH_TOO_LARGE", nil, "batch must contain at most %d schemas", maxBatchSize)
+	}
+
+	// Check every schema, keeping the diagnostics for the results
+	results := make([]domain.ImportResult, len(items))
+	var valid []domain.ImportItem
+	var positions []int
+	for i, item := range items {
+		diagnostics := domain.ValidateSchema(item.Schema)
+		results[i] = domain.ImportResult{Index: item.Index, SchemaName: item.Schema.SchemaName, Diagnostics: diagnostics}
+		if domain.HasErrors(diagnostics) {
+			results[i].Outcome = domain.ImportInvalid
+			results[i].Err = domain.InvalidArgumentError("INVALID_SCHEMA", nil, "schema '%s' is invalid", item.Schema.SchemaName)
+			continue
+		}
+		if err := domain.ValidateTaskStatuses(item.Schema.Tasks); err != nil {
+			results[i].Outcome = domain.ImportInvalid
+			results[i].Err = err
+			continue
+		}
+		item.Schema.Tasks = domain.CloneTasks(item.Schema.Tasks)
+		domain.SetTaskLevels(item.Schema.Tasks)
+		valid = append(valid, item)
+		positions = append(positions, i)
+	}
+
+	// Forward the valid ones to Storage
+	var err error
+	if len(valid) > 0 {
+		var stored []domain.ImportResult
+		stored, err = s.StorageProvider.ImportSchemas(ctx, valid, policy)
+		if err != nil {
+			fmt.Printf("Error importing Schemas: %s\n", err)
+		}
+		for j, result := range stored {
+			result.Diagnostics = results[positions[j]].Diagnostics
+			results[positions[j]] = result
+		}
+		if err != nil && stored == nil {
+			return nil, classify(err)
+		}
+	}
+
+	fmt.Println("END Schema.Import handler")
+	return results, classify(err)
+}
+
+func (s *Schema) GetByIDs(ctx context.Context, ids []string) ([]domain.SchemaResult, error) {
+	fmt.Println("START Schema.GetByIDs handler")
+
+	if err := checkBatch(ids); err != nil {
+		return nil, err
+	}
+
+	// Forward bulk fetch to Storage
+	results, err := s.StorageProvider.GetSchemasByIDs(ctx, ids)
+	if err != nil {
+		fmt.Printf("Error getting %d Schemas: %s\n", len(ids), err)
+	}
+
+	fmt.Println("END Schema.GetByIDs handler")
+	return results, classify(err)
+}
+
+func (s *Schema) DeleteByIDs(ctx context.Context, ids []string) ([]domain.SchemaResult, error) {
+	fmt.Println("START Schema.DeleteByIDs handler")
+
+	if err := checkBatch(ids); err != nil {
+		return nil, err
+	}
+
+	// Forward bulk deletion to Storage
+	results, err := s.StorageProvider.DeleteSchemasByIDs(ctx, ids)
+	if err != nil {
+		fmt.Printf("Error deleting %d Schemas: %s\n", len(ids), err)
+	}
+
+	fmt.Println("END Schema.DeleteByIDs handler")
+	return results, classify(err)
+}
+
+func checkBatch(ids []string) error {
+	if len(ids) == 0 {
+		return domain.InvalidArgumentError("EMPTY_BATCH", []domain.FieldViolation{
+			{Field: "schema_ids", Description: "must contain at least 1 item"},
+		}, "batch must contain at least one schema id")
+	}
+	if len(ids) > maxBatchSize {
+		return domain.InvalidArgumentError("BATCH_TOO_LARGE", []domain.FieldViolation{
+			{Field: "schema_ids", Description: fmt.Sprintf("must contain at most %d items", maxBatchSize)},
+		}, "batch must contain at most %d schema ids", maxBatchSize)
+	}
+	return nil
+}
+
+func (s *Schema) Search(ctx context.Context, query string, limit int) ([]domain.SearchResult, error) {
+	fmt.Println("START Schema.Search handler")
+
+	if strings.TrimSpace(query) == "" {
+		return nil, domain.InvalidArgumentError("EMPTY_QUERY", []domain.FieldViolation{
+			{Field: "query", Description: "must not be empty"},
+		}, "search query must not be empty")
+	}
+
+	// Clamp the number of results
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	// Forward search to Storage
+	results, err := s.StorageProvider.SearchSchemas(ctx, query, limit)
+	if err != nil {
+		fmt.Printf("Error searching Schemas with query=<%s>: %s\n", query, err)
+	}
+
+	fmt.Println("END Schema.Search handler")
+	return results, classify(err)
+}
+
+func (s *Schema) Watch(ctx context.Context, fromRevision int64, filter domain.EventFilter) (domain.EventStream, error) {
+	fmt.Println("START Schema.Watch handler")
+
+	if fromRevision < 0 {
+		return nil, domain.InvalidArgumentError("NEGATIVE_REVISION", []domain.FieldViolation{
+			{Field: "from_revision", Description: "must be greater than or equal to 0"},
+		}, "revision must not be negative")
+	}
+
+	// Forward subscription to Storage
+	stream, err := s.StorageProvider.WatchSchemas(ctx, fromRevision, filter)
+	if err != nil {
+		fmt.Printf("Error watching Schemas from revision=<%d>: %s\n", fromRevision, err)
+		return nil, classify(err)
+	}
+
+	fmt.Println("END Schema.Watch handler")
+	return stream, nil
+}
+
+func (s *Schema) ChangesSince(ctx context.Context, revision int64, limit int) (domain.ChangeSet, error) {
+	fmt.Println("START Schema.ChangesSince handler")
+
+	var violations []domain.FieldViolation
+	if revision < 0 {
+		violations = append(violations, domain.FieldViolation{Field: "revision", Description: "must be greater than or equal to 0"})
+	}
+	if limit < 0 {
+		violations = append(violations, domain.FieldViolation{Field: "limit", Description: "must be greater than or equal to 0"})
+	}
+	if len(violations) > 0 {
+		return domain.ChangeSet{}, domain.InvalidArgumentError("INVALID_SYNC_REQUEST", violations, "revision and limit must not be negative")
+	}
+	if limit == 0 || limit > maxChangesLimit {
+		limit = maxChangesLimit
+	}
+
+	// Forward request to Storage
+	changes, err := s.StorageProvider.GetChangesSince(ctx, revision, limit)
+	if err != nil {
+		fmt.Printf("Error getting changes since revision=<%d>: %s\n", revision, err)
+		return domain.ChangeSet{}, classify(err)
+	}
+
+	fmt.Println("END Schema.ChangesSince handler")
+	return changes, nil
 }
@@ -1,16 +1,20 @@
 package schema_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"server/internal/domain"
 	"server/internal/handlers/schema"
+	"server/internal/providers/storage"
 	"testing"
 	"time"
 )
 
 // Mock data and variables
 
+var ctx context.Context = context.Background()
 var now time.Time = time.Now()
 var schemaId string = "schemaID"
 var domainSchema domain.Schema = domain.Schema{
@@ -20,6 +24,7 @@ var domainSchema domain.Schema = domain.Schema{
 	CreatedAt:  now,
 	UpdatedAt:  now,
 	Tasks:      []domain.Task{},
+	State:      domain.StateDraft,
 }
 var domainSchema2 domain.Schema = domain.Schema{
 	SchemaID:   schemaId + "2",
@@ -28,13 +33,14 @@ var domainSchema2 domain.Schema = domain.Schema{
 	CreatedAt:  now,
 	UpdatedAt:  now,
 	Tasks:      []domain.Task{},
+	State:      domain.StatePublished,
 }
 
 var task1 domain.Task = domain.Task{
 	ID:          1,
 	Level:       1,
 	Name:        "Task 1",
-	Status:      "NOT_STARTED",
+	Status:      domain.TaskNotStarted,
 	BlockedBy:   []int64{},
 	Responsible: "Doctor1",
 	TimeLimit:   3600,
@@ -48,7 +54,7 @@ var task3 domain.Task = domain.Task{
 	ID:          3,
 	Level:       2,
 	Name:        "Task 2",
-	Status:      "NOT_STARTED",
+	Status:      domain.TaskNotStarted,
 	BlockedBy:   []int64{},
 	Responsible: "Doctor2",
 	TimeLimit:   7200,
@@ -62,7 +68,7 @@ var task2 domain.Task = domain.Task{
 	ID:          2,
 	Level:       1,
 	Name:        "Task 3",
-	Status:      "NOT_STARTED",
+	Status:      domain.TaskNotStarted,
 	BlockedBy:   []int64{},
 	Responsible: "Doctor3",
 	TimeLimit:   5400,
@@ -78,9 +84,9 @@ var emptyTasks []domain.Task = []domain.Task{}
 
 type MockStorageProvider struct{}
 
-func (msp *MockStorageProvider) CreateSchema(authorID string, schemaName string, tasks []domain.Task) (domain.Schema, error) {
+func (msp *MockStorageProvider) CreateSchema(ctx context.Context, authorID string, schemaName string, tasks []domain.Task) (domain.Schema, error) {
 	if schemaName == "UsedSchemaName" {
-		return domain.Schema{}, fmt.Errorf("schema with name '%s' already exists", schemaName)
+		return domain.Schema{}, domain.SchemaNameTakenError(schemaName)
 	}
 
 	schema := domain.Schema{
@@ -95,13 +101,16 @@ func (msp *MockStorageProvider) CreateSchema(authorID string, schemaName string,
 	return schema, nil
 }
 
-func (msp *MockStorageProvider) GetAllSchemas() ([]domain.Schema, error) {
+func (msp *MockStorageProvider) GetAllSchemas(ctx context.Context) ([]domain.Schema, error) {
 	return []domain.Schema{domainSchema, domainSchema2}, nil
 }
 
-func (msp *MockStorageProvider) GetSchemaByID(id string) (domain.Schema, error) {
+func (msp *MockStorageProvider) GetSchemaByID(ctx context.Context, id string) (domain.Schema, error) {
 	if id == "NotPresentSchemaID" {
-		return domain.Schema{}, fmt.Errorf("schema with id=<%s> not found", id)
+		return domain.Schema{}, domain.SchemaNotFoundError(id)
+	}
+	if id == "BrokenSchemaID" {
+		return domain.Schema{}, fmt.Errorf("unexpected storage failure")
 	}
 
 	schema := domain.Schema{
@@ -116,14 +125,242 @@ func (msp *MockStorageProvider) GetSchemaByID(id string) (domain.Schema, error)
 	return schema, nil
 }
 
-func (msp *MockStorageProvider) DeleteSchemaByID(id string) error {
+func (msp *MockStorageProvider) DeleteSchemaByID(ctx context.Context, id string) error {
 	if id == "NotPresentSchemaID" {
-		return fmt.Errorf("schema with id=<%s> not found", id)
+		return domain.SchemaNotFoundError(id)
 	}
 
 	return nil
 }
 
+func (msp *MockStorageProvider) SearchSchemas(ctx context.Context, query string, limit int) ([]domain.SearchResult, error) {
+	results := []domain.SearchResult{
+		{Schema: domainSchema, Score: 2},
+		{Schema: domainSchema2, Score: 1},
+	}
+	if limit < len(results) {
+		results = results[:limit]
+	}
+
+	return results, nil
+}
+
+func (msp *MockStorageProvider) GetSchemasByIDs(ctx context.Context, ids []string) ([]domain.SchemaResult, error) {
+	var results []domain.SchemaResult
+	for _, id := range ids {
+		schema, err := msp.GetSchemaByID(ctx, id)
+		results = append(results, domain.SchemaResult{SchemaID: id, Schema: schema, Err: err})
+	}
+
+	return results, nil
+}
+
+func (msp *MockStorageProvider) DeleteSchemasByIDs(ctx context.Context, ids []string) ([]domain.SchemaResult, error) {
+	var results []domain.SchemaResult
+	for _, id := range ids {
+		results = append(results, domain.SchemaResult{SchemaID: id, Err: msp.DeleteSchemaByID(ctx, id)})
+	}
+
+	return results, nil
+}
+
+type MockEventStream struct {
+	events chan domain.SchemaEvent
+}
+
+func (mes *MockEventStream) Events() <-chan domain.SchemaEvent { return mes.events }
+func (mes *MockEventStream) Err() error                        { return nil }
+func (mes *MockEventStream) Close()                            {}
+
+func (msp *MockStorageProvider) WatchSchemas(ctx context.Context, fromRevision int64, filter domain.EventFilter) (domain.EventStream, error) {
+	if fromRevision > 100 {
+		return nil, domain.NewError(domain.ErrFailedPrecondition, "REVISION_IN_FUTURE", nil, "revision %d is ahead", fromRevision)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return &MockEventStream{events: make(chan domain.SchemaEvent)}, nil
+}
+
+func (msp *MockStorageProvider) ExportSchemas(ctx context.Context, filter domain.ExportFilter) (domain.Snapshot, error) {
+	snapshot := domain.Snapshot{Revision: 7}
+	for _, schema := range []domain.Schema{domainSchema, domainSchema2} {
+		if filter.Matches(schema) {
+			snapshot.Schemas = append(snapshot.Schemas, schema)
+		}
+	}
+
+	return snapshot, nil
+}
+
+func (msp *MockStorageProvider) GetChangesSince(ctx context.Context, revision int64, limit int) (domain.ChangeSet, error) {
+	if revision > 100 {
+		return domain.ChangeSet{}, domain.NewError(domain.ErrFailedPrecondition, "REVISION_IN_FUTURE", nil, "revision %d is ahead", revision)
+	}
+
+	changes := domain.ChangeSet{
+		Schemas:    []domain.Schema{domainSchema},
+		Tombstones: []domain.Tombstone{{SchemaID: "deletedSchemaID", Revision: revision + 2, DeletedAt: now}},
+		Revision:   revision + 2,
+		HasMore:    limit < 2,
+	}
+
+	return changes, nil
+}
+
+func (msp *MockStorageProvider) CloneSchema(ctx context.Context, sourceID string, authorID string, schemaName string, renumberTasks bool) (domain.Schema, error) {
+	if sourceID == "NotPresentSchemaID" {
+		return domain.Schema{}, domain.SchemaNotFoundError(sourceID)
+	}
+	if schemaName == domainSchema.SchemaName {
+		return domain.Schema{}, domain.SchemaNameTakenError(schemaName)
+	}
+
+	schema := domainSchema
+	schema.AuthorID = authorID
+	schema.SchemaName = schemaName
+	schema.ParentID = sourceID
+
+	return schema, nil
+}
+
+func (msp *MockStorageProvider) ImportSchemas(ctx context.Context, items []domain.ImportItem, policy domain.ConflictPolicy) ([]domain.ImportResult, error) {
+	var results []domain.ImportResult
+	for i, item := range items {
+		results = append(results, domain.ImportResult{
+			Index:      item.Index,
+			Outcome:    domain.ImportCreated,
+			SchemaID:   fmt.Sprintf("imported%d", i),
+			SchemaName: item.Schema.SchemaName,
+		})
+	}
+
+	return results, nil
+}
+
+func (msp *MockStorageProvider) GetDerivedSchemas(ctx context.Context, id string, transitive bool) ([]domain.Schema, error) {
+	if id == "NotPresentSchemaID" {
+		return nil, domain.SchemaNotFoundError(id)
+	}
+
+	derived := domainSchema2
+	derived.ParentID = id
+
+	return []domain.Schema{derived}, nil
+}
+
+func (msp *MockStorageProvider) GetSchemaByName(ctx context.Context, name string) (domain.Schema, error) {
+	if name != domainSchema.SchemaName {
+		return domain.Schema{}, domain.SchemaNameNotFoundError(name)
+	}
+
+	return domainSchema, nil
+}
+
+func (msp *MockStorageProvider) GetSchemasByAuthor(ctx context.Context, authorID string) ([]domain.Schema, error) {
+	schemas := []domain.Schema{}
+	for _, schema := range []domain.Schema{domainSchema, domainSchema2} {
+		if schema.AuthorID == authorID {
+			schemas = append(schemas, schema)
+		}
+	}
+
+	return schemas, nil
+}
+
+func (msp *MockStorageProvider) GetSchemaBySlug(ctx context.Context, slug string) (domain.Schema, bool, error) {
+	switch slug {
+	case "schemaname":
+		return domainSchema, false, nil
+	case "former-name":
+		return domainSchema, true, nil
+	}
+
+	return domain.Schema{}, false, domain.SlugNotFoundError(slug)
+}
+
+func (msp *MockStorageProvider) RenameSchema(ctx context.Context, id string, name string) (domain.Schema, error) {
+	if id == "NotPresentSchemaID" {
+		return domain.Schema{}, domain.SchemaNotFoundError(id)
+	}
+	if name == "UsedSchemaName" {
+		return domain.Schema{}, domain.SchemaNameTakenError(name)
+	}
+
+	schema := domainSchema
+	schema.SchemaName = name
+	schema.Slug = domain.Slugify(name)
+
+	return schema, nil
+}
+
+func (msp *MockStorageProvider) UpdateSchema(ctx context.Context, id string, tasks []domain.Task, assignTaskIDs bool) (domain.Schema, error) {
+	if id == "NotPresentSchemaID" {
+		return domain.Schema{}, domain.SchemaNotFoundError(id)
+	}
+
+	schema := domainSchema
+	schema.Tasks = tasks
+	if assignTaskIDs {
+		domain.AssignTaskIDs(schema.Tasks)
+	}
+
+	return schema, nil
+}
+
+func (msp *MockStorageProvider) PublishSchema(ctx context.Context, id string) (domain.SchemaVersion, error) {
+	if id == "NotPresentSchemaID" {
+		return domain.SchemaVersion{}, domain.SchemaNotFoundError(id)
+	}
+
+	return domain.SchemaVersion{Version: 1, SchemaName: domainSchema.SchemaName, Tasks: domainSchema.Tasks, PublishedAt: now}, nil
+}
+
+func (msp *MockStorageProvider) GetSchemaVersions(ctx context.Context, id string) ([]domain.SchemaVersion, error) {
+	if id == "NotPresentSchemaID" {
+		return nil, domain.SchemaNotFoundError(id)
+	}
+
+	return []domain.SchemaVersion{{Version: 1, SchemaName: domainSchema.SchemaName, Tasks: domainSchema.Tasks, PublishedAt: now}}, nil
+}
+
+func (msp *MockStorageProvider) TransitionSchema(ctx context.Context, id string, state domain.SchemaState, comment string) (domain.Schema, error) {
+	if id == "NotPresentSchemaID" {
+		return domain.Schema{}, domain.SchemaNotFoundError(id)
+	}
+	if err := domain.CheckTransition(domainSchema.State, state); err != nil {
+		return domain.Schema{}, err
+	}
+
+	schema := domainSchema
+	schema.State = state
+
+	return schema, nil
+}
+
+func (msp *MockStorageProvider) ReviewSchema(ctx context.Context, id string, decision domain.ReviewDecision, comment string) (domain.Schema, error) {
+	if id == "NotPresentSchemaID" {
+		return domain.Schema{}, domain.SchemaNotFoundError(id)
+	}
+
+	schema := domainSchema
+	schema.State = domain.StateApproved
+	if decision == domain.DecisionReject {
+		schema.State = domain.StateDraft
+	}
+
+	return schema, nil
+}
+
+func (msp *MockStorageProvider) GetSchemaVersion(ctx context.Context, id string, version int64) (domain.SchemaVersion, error) {
+	if version != 1 {
+		return domain.SchemaVersion{}, domain.SchemaVersionNotFoundError(id, version)
+	}
+
+	return domain.SchemaVersion{Version: 1, SchemaName: domainSchema.SchemaName, Tasks: domainSchema.Tasks, PublishedAt: now}, nil
+}
+
 // Tests
 
 func TestCreate(t *testing.T) {
@@ -131,15 +368,15 @@ func TestCreate(t *testing.T) {
 	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
 
 	t.Run("UsedSchemaName", func(t *testing.T) {
-		_, err := schemaService.Create(domainSchema.AuthorID, "UsedSchemaName", emptyTasks)
+		_, err := schemaService.Create(ctx, domainSchema.AuthorID, "UsedSchemaName", emptyTasks, false)
 
-		if err == nil {
-			t.Errorf("Expected error, got nil")
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("Expected ErrAlreadyExists, got %v", err)
 		}
 	})
 
 	t.Run("ValidSchemaNameWithoutTasks", func(t *testing.T) {
-		createdSchema, err := schemaService.Create(domainSchema.AuthorID, domainSchema.SchemaName, emptyTasks)
+		createdSchema, err := schemaService.Create(ctx, domainSchema.AuthorID, domainSchema.SchemaName, emptyTasks, false)
 
 		if err != nil {
 			t.Errorf("Unexpected error: %v", err)
@@ -160,7 +397,7 @@ func TestCreate(t *testing.T) {
 
 	t.Run("ValidSchemaNameWithTasks", func(t *testing.T) {
 		expectedTasks := []domain.Task{task1, task2}
-		createdSchema, err := schemaService.Create(domainSchema.AuthorID, domainSchema.SchemaName, expectedTasks)
+		createdSchema, err := schemaService.Create(ctx, domainSchema.AuthorID, domainSchema.SchemaName, expectedTasks, false)
 
 		if err != nil {
 			t.Errorf("Unexpected error: %v", err)
@@ -178,6 +415,172 @@ func TestCreate(t *testing.T) {
 			t.Errorf("Expected %d tasks, got %d", len(expectedTasks), len(createdSchema.Tasks))
 		}
 	})
+
+	invalidGraphs := []struct {
+		name    string
+		tasks   []domain.Task
+		field   string
+		message string
+	}{
+		{
+			name:    "UnknownBlocker",
+			tasks:   []domain.Task{{ID: 1}, {ID: 2, BlockedBy: []int64{1, 7}}},
+			field:   "tasks[1].blocked_by[1]",
+			message: "task 2 is blocked by unknown task 7",
+		},
+		{
+			name:    "SelfBlocked",
+			tasks:   []domain.Task{{ID: 1, Children: []domain.Task{{ID: 2, BlockedBy: []int64{2}}}}},
+			field:   "tasks[0].children[0].blocked_by[0]",
+			message: "task 2 is blocked by itself",
+		},
+		{
+			name: "Cycle",
+			tasks: []domain.Task{
+				{ID: 1, BlockedBy: []int64{3}, Children: []domain.Task{{ID: 2, BlockedBy: []int64{1}}}},
+				{ID: 3, BlockedBy: []int64{2}},
+			},
+			field:   "tasks[0].blocked_by",
+			message: "tasks block each other in a cycle: 1 -> 3 -> 2 -> 1",
+		},
+	}
+	for _, tc := range invalidGraphs {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := schemaService.Create(ctx, domainSchema.AuthorID, domainSchema.SchemaName, tc.tasks, false)
+
+			var domainErr *domain.Error
+			if !errors.As(err, &domainErr) || !errors.Is(err, domain.ErrInvalidArgument) {
+				t.Fatalf("Expected ErrInvalidArgument, got %v", err)
+			}
+			if domainErr.Reason != "INVALID_TASK_GRAPH" || len(domainErr.Violations) != 1 {
+				t.Fatalf("Unexpected error %+v", domainErr)
+			}
+			if v := domainErr.Violations[0]; v.Field != tc.field || v.Description != tc.message {
+				t.Errorf("Expected violation %s: %s, got %+v", tc.field, tc.message, v)
+			}
+		})
+	}
+}
+
+func TestCreateTaskIDs(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("DuplicateID", func(t *testing.T) {
+		tasks := []domain.Task{{ID: 1, Children: []domain.Task{{ID: 2}}}, {ID: 2}}
+		_, err := schemaService.Create(ctx, domainSchema.AuthorID, domainSchema.SchemaName, tasks, false)
+
+		var domainErr *domain.Error
+		if !errors.As(err, &domainErr) || domainErr.Reason != "INVALID_TASK_ID" {
+			t.Fatalf("Expected INVALID_TASK_ID, got %v", err)
+		}
+		expected := []domain.FieldViolation{{Field: "tasks[1].id", Description: "task id 2 is already used by tasks[0].children[0]"}}
+		if !reflect.DeepEqual(domainErr.Violations, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, domainErr.Violations)
+		}
+	})
+
+	t.Run("ZeroIDsWithoutAssigning", func(t *testing.T) {
+		_, err := schemaService.Create(ctx, domainSchema.AuthorID, domainSchema.SchemaName, []domain.Task{{}, {}}, false)
+
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("Expected ErrInvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("AssignIDs", func(t *testing.T) {
+		tasks := []domain.Task{
+			{ID: 0, Name: "a", Status: domain.TaskDone, Children: []domain.Task{{ID: 0, Name: "b", Status: domain.TaskDone, BlockedBy: []int64{70}}}},
+			{ID: 70, Name: "c", Status: domain.TaskDone},
+			{ID: 0, Name: "d", Status: domain.TaskDone, BlockedBy: []int64{70}},
+		}
+		createdSchema, err := schemaService.Create(ctx, domainSchema.AuthorID, domainSchema.SchemaName, tasks, true)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expected := []domain.Task{
+			{ID: 71, Level: 1, Name: "a", Status: domain.TaskDone, Children: []domain.Task{{ID: 72, Level: 2, Name: "b", Status: domain.TaskDone, BlockedBy: []int64{70}}}},
+			{ID: 70, Level: 1, Name: "c", Status: domain.TaskDone},
+			{ID: 73, Level: 1, Name: "d", Status: domain.TaskDone, BlockedBy: []int64{70}},
+		}
+		if !reflect.DeepEqual(createdSchema.Tasks, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, createdSchema.Tasks)
+		}
+		if tasks[0].ID != 0 {
+			t.Errorf("Expected the request tasks to be left as sent, got %+v", tasks)
+		}
+	})
+
+	t.Run("AssignIDsBlockedByZero", func(t *testing.T) {
+		tasks := []domain.Task{{ID: 0}, {ID: 0, BlockedBy: []int64{0}}}
+		_, err := schemaService.Create(ctx, domainSchema.AuthorID, domainSchema.SchemaName, tasks, true)
+
+		var domainErr *domain.Error
+		if !errors.As(err, &domainErr) || len(domainErr.Violations) != 1 || domainErr.Violations[0].Field != "tasks[1].blocked_by[0]" {
+			t.Errorf("Expected a violation for tasks[1].blocked_by[0], got %v", err)
+		}
+	})
+
+	t.Run("AssignIDsDuplicateID", func(t *testing.T) {
+		tasks := []domain.Task{{ID: 5}, {ID: 0}, {ID: 5}}
+		_, err := schemaService.Create(ctx, domainSchema.AuthorID, domainSchema.SchemaName, tasks, true)
+
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("Expected ErrInvalidArgument, got %v", err)
+		}
+	})
+}
+
+func TestCreateTaskLevels(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("LevelMismatch", func(t *testing.T) {
+		tasks := []domain.Task{{ID: 1, Level: 1, Children: []domain.Task{{ID: 2, Level: 1}}}}
+		_, err := schemaService.Create(ctx, domainSchema.AuthorID, domainSchema.SchemaName, tasks, false)
+
+		var domainErr *domain.Error
+		if !errors.As(err, &domainErr) || domainErr.Reason != "INVALID_TASK_LEVEL" {
+			t.Fatalf("Expected INVALID_TASK_LEVEL, got %v", err)
+		}
+		expected := []domain.FieldViolation{{Field: "tasks[0].children[0].level", Description: "task is at depth 2 but has level 1"}}
+		if !reflect.DeepEqual(domainErr.Violations, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, domainErr.Violations)
+		}
+	})
+
+	t.Run("LevelsDerived", func(t *testing.T) {
+		tasks := []domain.Task{{ID: 1, Status: domain.TaskBlocked, Children: []domain.Task{{ID: 2, Level: 2, Status: domain.TaskBlocked, Children: []domain.Task{{ID: 3, Status: domain.TaskDone}}}}}}
+		createdSchema, err := schemaService.Create(ctx, domainSchema.AuthorID, domainSchema.SchemaName, tasks, false)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expected := []domain.Task{{ID: 1, Level: 1, Status: domain.TaskBlocked, Children: []domain.Task{{ID: 2, Level: 2, Status: domain.TaskBlocked, Children: []domain.Task{{ID: 3, Level: 3, Status: domain.TaskDone}}}}}}
+		if !reflect.DeepEqual(createdSchema.Tasks, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, createdSchema.Tasks)
+		}
+		if tasks[0].Level != 0 {
+			t.Errorf("Expected the request tasks to be left as sent, got %+v", tasks)
+		}
+	})
+}
+
+func TestCreateTaskStatuses(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	tasks := []domain.Task{{ID: 1, Status: domain.TaskDone, Children: []domain.Task{{ID: 2, Status: "FINISHED"}, {ID: 3}}}}
+	_, err := schemaService.Create(ctx, domainSchema.AuthorID, domainSchema.SchemaName, tasks, false)
+
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) || domainErr.Reason != "INVALID_TASK_STATUS" {
+		t.Fatalf("Expected INVALID_TASK_STATUS, got %v", err)
+	}
+	if len(domainErr.Violations) != 2 || domainErr.Violations[0].Field != "tasks[0].children[0].status" || domainErr.Violations[1].Field != "tasks[0].children[1].status" {
+		t.Errorf("Expected violations for both children, got %+v", domainErr.Violations)
+	}
 }
 
 func TestGetAll(t *testing.T) {
@@ -185,7 +588,7 @@ func TestGetAll(t *testing.T) {
 	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
 
 	t.Run("GetAll", func(t *testing.T) {
-		foundSchemas, err := schemaService.GetAll()
+		foundSchemas, err := schemaService.GetAll(ctx, nil)
 		expectedSchemas := []domain.Schema{domainSchema, domainSchema2}
 
 		if err != nil {
@@ -202,6 +605,14 @@ func TestGetAll(t *testing.T) {
 		}
 
 	})
+
+	t.Run("FilterByState", func(t *testing.T) {
+		foundSchemas, err := schemaService.GetAll(ctx, []domain.SchemaState{domain.StatePublished})
+
+		if err != nil || len(foundSchemas) != 1 || foundSchemas[0].SchemaID != domainSchema2.SchemaID {
+			t.Errorf("Expected only the published schema, got %+v (%v)", foundSchemas, err)
+		}
+	})
 }
 
 func TestGetByID(t *testing.T) {
@@ -209,15 +620,23 @@ func TestGetByID(t *testing.T) {
 	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
 
 	t.Run("NotPresentSchemaID", func(t *testing.T) {
-		_, err := schemaService.GetByID("NotPresentSchemaID")
+		_, err := schemaService.GetByID(ctx, "NotPresentSchemaID")
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
 
-		if err == nil {
-			t.Errorf("Expected error, got nil")
+	t.Run("UnexpectedStorageError", func(t *testing.T) {
+		_, err := schemaService.GetByID(ctx, "BrokenSchemaID")
+
+		if !errors.Is(err, domain.ErrInternal) {
+			t.Errorf("Expected ErrInternal, got %v", err)
 		}
 	})
 
 	t.Run("PresentSchemaID", func(t *testing.T) {
-		foundSchema, err := schemaService.GetByID(schemaId)
+		foundSchema, err := schemaService.GetByID(ctx, schemaId)
 
 		if err != nil {
 			t.Errorf("Unexpected error: %v", err)
@@ -242,18 +661,737 @@ func TestDeleteByID(t *testing.T) {
 	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
 
 	t.Run("NotPresentSchemaID", func(t *testing.T) {
-		err := schemaService.DeleteByID("NotPresentSchemaID")
+		err := schemaService.DeleteByID(ctx, "NotPresentSchemaID")
 
-		if err == nil {
-			t.Errorf("Expected error, got nil")
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
 		}
 	})
 
 	t.Run("PresentSchemaID", func(t *testing.T) {
-		err := schemaService.DeleteByID(schemaId)
+		err := schemaService.DeleteByID(ctx, schemaId)
+
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+}
+
+func TestSearch(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("EmptyQuery", func(t *testing.T) {
+		_, err := schemaService.Search(ctx, "   ", 10)
+
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("Expected ErrInvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("DefaultLimit", func(t *testing.T) {
+		results, err := schemaService.Search(ctx, "schema", 0)
+
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+
+		if len(results) != 2 {
+			t.Errorf("Expected 2 results, got %d", len(results))
+		}
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		results, err := schemaService.Search(ctx, "schema", 1)
+
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+
+		if len(results) != 1 {
+			t.Errorf("Expected 1 result, got %d", len(results))
+		}
+	})
+}
+
+func TestGetByIDs(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("EmptyBatch", func(t *testing.T) {
+		_, err := schemaService.GetByIDs(ctx, []string{})
+
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("Expected ErrInvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("TooLargeBatch", func(t *testing.T) {
+		_, err := schemaService.GetByIDs(ctx, make([]string, 1001))
+
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("Expected ErrInvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("PerItemResults", func(t *testing.T) {
+		results, err := schemaService.GetByIDs(ctx, []string{schemaId, "NotPresentSchemaID"})
+
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+
+		if len(results) != 2 {
+			t.Fatalf("Expected 2 results, got %d", len(results))
+		}
+
+		if results[0].Err != nil || results[0].Schema.SchemaID != schemaId {
+			t.Errorf("Expected schema %s, got %+v", schemaId, results[0])
+		}
+
+		if !errors.Is(results[1].Err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", results[1].Err)
+		}
+	})
+}
+
+func TestDeleteByIDs(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("EmptyBatch", func(t *testing.T) {
+		_, err := schemaService.DeleteByIDs(ctx, nil)
+
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("Expected ErrInvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("PerItemResults", func(t *testing.T) {
+		results, err := schemaService.DeleteByIDs(ctx, []string{"NotPresentSchemaID", schemaId})
 
 		if err != nil {
 			t.Errorf("Unexpected error: %v", err)
 		}
+
+		if len(results) != 2 || !errors.Is(results[0].Err, domain.ErrNotFound) || results[1].Err != nil {
+			t.Errorf("Unexpected results %+v", results)
+		}
+	})
+}
+
+func TestWatch(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("NegativeRevision", func(t *testing.T) {
+		_, err := schemaService.Watch(ctx, -1, domain.EventFilter{})
+
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("Expected ErrInvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("RevisionInFuture", func(t *testing.T) {
+		_, err := schemaService.Watch(ctx, 101, domain.EventFilter{})
+
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected ErrFailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("CanceledCall", func(t *testing.T) {
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := schemaService.Watch(canceled, 0, domain.EventFilter{})
+
+		if !errors.Is(err, context.Canceled) || errors.Is(err, domain.ErrInternal) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("Subscribes", func(t *testing.T) {
+		stream, err := schemaService.Watch(ctx, 0, domain.EventFilter{AuthorID: "authorID"})
+
+		if err != nil || stream == nil {
+			t.Errorf("Expected stream, got %v", err)
+		}
+	})
+}
+
+func TestExport(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	snapshot, err := schemaService.Export(ctx, domain.ExportFilter{AuthorIDs: []string{domainSchema2.AuthorID}})
+
+	if err != nil || snapshot.Revision != 7 || !reflect.DeepEqual(snapshot.Schemas, []domain.Schema{domainSchema2}) {
+		t.Errorf("Expected snapshot of %s, got %+v (%v)", domainSchema2.SchemaID, snapshot, err)
+	}
+}
+
+func TestChangesSince(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("NegativeArguments", func(t *testing.T) {
+		_, err := schemaService.ChangesSince(ctx, -1, -1)
+
+		var domainErr *domain.Error
+		if !errors.As(err, &domainErr) || !errors.Is(err, domain.ErrInvalidArgument) || len(domainErr.Violations) != 2 {
+			t.Errorf("Expected two field violations, got %v", err)
+		}
+	})
+
+	t.Run("RevisionInFuture", func(t *testing.T) {
+		_, err := schemaService.ChangesSince(ctx, 101, 0)
+
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected ErrFailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("DefaultLimit", func(t *testing.T) {
+		changes, err := schemaService.ChangesSince(ctx, 10, 0)
+
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+
+		if changes.Revision != 12 || changes.HasMore {
+			t.Errorf("Unexpected changes %+v", changes)
+		}
+	})
+}
+
+func TestClone(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("NameTaken", func(t *testing.T) {
+		_, err := schemaService.Clone(ctx, schemaId, "authorID", domainSchema.SchemaName, false)
+
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("Expected ErrAlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("Clones", func(t *testing.T) {
+		cloned, err := schemaService.Clone(ctx, schemaId, "newAuthor", "copy", true)
+
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+
+		if cloned.ParentID != schemaId || cloned.AuthorID != "newAuthor" || cloned.SchemaName != "copy" {
+			t.Errorf("Unexpected clone %+v", cloned)
+		}
+	})
+}
+
+func TestListDerived(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("SchemaNotFound", func(t *testing.T) {
+		_, err := schemaService.ListDerived(ctx, "NotPresentSchemaID", false)
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("ListsDerivatives", func(t *testing.T) {
+		schemas, err := schemaService.ListDerived(ctx, schemaId, false)
+
+		if err != nil || len(schemas) != 1 || schemas[0].ParentID != schemaId {
+			t.Errorf("Unexpected derivatives %+v, %v", schemas, err)
+		}
+	})
+}
+
+func TestValidate(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	codes := func(diagnostics []domain.Diagnostic) []string {
+		var codes []string
+		for _, d := range diagnostics {
+			codes = append(codes, d.Code)
+		}
+		return codes
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		tasks := []domain.Task{
+			{ID: 1, Level: 1, TimeLimit: 60, Children: []domain.Task{
+				{ID: 2, Level: 2, TimeLimit: 30},
+			}},
+			{ID: 3, BlockedBy: []int64{1, 2}},
+		}
+		diagnostics, err := schemaService.Validate(ctx, "authorID", "newSchema", tasks)
+
+		if err != nil || len(diagnostics) != 0 {
+			t.Errorf("Expected no diagnostics, got %+v (%v)", diagnostics, err)
+		}
+	})
+
+	t.Run("NameTaken", func(t *testing.T) {
+		diagnostics, _ := schemaService.Validate(ctx, "authorID", domainSchema.SchemaName, []domain.Task{{ID: 1}})
+
+		if !reflect.DeepEqual(codes(diagnostics), []string{"SCHEMA_NAME_TAKEN"}) || !domain.HasErrors(diagnostics) {
+			t.Errorf("Expected SCHEMA_NAME_TAKEN error, got %+v", diagnostics)
+		}
+	})
+
+	t.Run("EmptySchema", func(t *testing.T) {
+		diagnostics, _ := schemaService.Validate(ctx, "authorID", "newSchema", nil)
+
+		if !reflect.DeepEqual(codes(diagnostics), []string{"EMPTY_SCHEMA"}) || domain.HasErrors(diagnostics) {
+			t.Errorf("Expected EMPTY_SCHEMA warning, got %+v", diagnostics)
+		}
+	})
+
+	t.Run("StructuralErrors", func(t *testing.T) {
+		tasks := []domain.Task{
+			{ID: 1, Level: 1, TimeLimit: 60, BlockedBy: []int64{3}, Children: []domain.Task{
+				{ID: 2, Level: 3, TimeLimit: 90, BlockedBy: []int64{2, 7}},
+				{ID: 1, Level: 2},
+			}},
+			{ID: 3, Level: 1, BlockedBy: []int64{4}},
+			{ID: 4, Level: 1, BlockedBy: []int64{1}},
+		}
+		diagnostics, _ := schemaService.Validate(ctx, "authorID", "newSchema", tasks)
+
+		expected := []domain.Diagnostic{
+			{Severity: domain.SeverityError, Code: "LEVEL_MISMATCH", Field: "tasks[0].children[0].level", TaskPath: []int64{1, 2},
+				Message: "task is at depth 2 but has level 3"},
+			{Severity: domain.SeverityWarning, Code: "TIME_LIMIT_EXCEEDS_PARENT", Field: "tasks[0].children[0].time_limit", TaskPath: []int64{1, 2},
+				Message: "time limit 90 exceeds the time limit 60 of the parent task"},
+			{Severity: domain.SeverityError, Code: "DUPLICATE_TASK_ID", Field: "tasks[0].children[1].id", TaskPath: []int64{1, 1},
+				Message: "task id 1 is already used by tasks[0]"},
+			{Severity: domain.SeverityError, Code: "SELF_BLOCKED", Field: "tasks[0].children[0].blocked_by[0]", TaskPath: []int64{1, 2},
+				Message: "task 2 is blocked by itself"},
+			{Severity: domain.SeverityError, Code: "UNKNOWN_BLOCKER", Field: "tasks[0].children[0].blocked_by[1]", TaskPath: []int64{1, 2},
+				Message: "task 2 is blocked by unknown task 7"},
+			{Severity: domain.SeverityError, Code: "BLOCKED_BY_CYCLE", Field: "tasks[0].blocked_by", TaskPath: []int64{1},
+				Message: "tasks block each other in a cycle: 1 -> 3 -> 4 -> 1"},
+		}
+		if !reflect.DeepEqual(diagnostics, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, diagnostics)
+		}
+	})
+}
+
+func TestImport(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("InvalidAndValid", func(t *testing.T) {
+		items := []domain.ImportItem{
+			{Index: 0, Schema: domain.Schema{AuthorID: "authorID", SchemaName: "first", Tasks: []domain.Task{{ID: 1, Status: domain.TaskNotStarted}}}},
+			{Index: 1, Schema: domain.Schema{AuthorID: "authorID", SchemaName: "cyclic", Tasks: []domain.Task{{ID: 1, BlockedBy: []int64{1}}}}},
+			{Index: 2, Schema: domain.Schema{AuthorID: "authorID", SchemaName: "empty"}},
+		}
+		results, err := schemaService.Import(ctx, items, domain.ConflictFail)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		outcomes := []domain.ImportOutcome{results[0].Outcome, results[1].Outcome, results[2].Outcome}
+		expected := []domain.ImportOutcome{domain.ImportCreated, domain.ImportInvalid, domain.ImportCreated}
+		if !reflect.DeepEqual(outcomes, expected) {
+			t.Errorf("Expected outcomes %v, got %v", expected, outcomes)
+		}
+		if results[2].SchemaID != "imported1" || len(results[2].Diagnostics) != 1 {
+			t.Errorf("Expected stored result with EMPTY_SCHEMA warning, got %+v", results[2])
+		}
+		if !errors.Is(results[1].Err, domain.ErrInvalidArgument) {
+			t.Errorf("Expected invalid argument error, got %v", results[1].Err)
+		}
+	})
+
+	t.Run("BatchTooLarge", func(t *testing.T) {
+		_, err := schemaService.Import(ctx, make([]domain.ImportItem, 1001), domain.ConflictFail)
+
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("Expected invalid argument error, got %v", err)
+		}
+	})
+}
+
+func TestGetByName(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("NotPresentName", func(t *testing.T) {
+		_, err := schemaService.GetByName(ctx, "NotPresentName")
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("PresentName", func(t *testing.T) {
+		foundSchema, err := schemaService.GetByName(ctx, domainSchema.SchemaName)
+
+		if err != nil || foundSchema.SchemaID != schemaId {
+			t.Errorf("Expected schema %s, got %+v (%v)", schemaId, foundSchema, err)
+		}
+	})
+}
+
+func TestListByAuthor(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	schemas, err := schemaService.ListByAuthor(ctx, domainSchema2.AuthorID, nil)
+
+	if err != nil || len(schemas) != 1 || schemas[0].SchemaID != domainSchema2.SchemaID {
+		t.Errorf("Expected the schema of %s, got %+v (%v)", domainSchema2.AuthorID, schemas, err)
+	}
+}
+
+func TestGetBySlug(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("IgnoresCase", func(t *testing.T) {
+		foundSchema, alias, err := schemaService.GetBySlug(ctx, "SchemaName")
+
+		if err != nil || alias || foundSchema.SchemaID != schemaId {
+			t.Errorf("Expected schema %s by its slug, got %+v %v (%v)", schemaId, foundSchema, alias, err)
+		}
+	})
+
+	t.Run("Alias", func(t *testing.T) {
+		_, alias, err := schemaService.GetBySlug(ctx, "former-name")
+
+		if err != nil || !alias {
+			t.Errorf("Expected an alias, got %v (%v)", alias, err)
+		}
+	})
+
+	t.Run("NotPresentSlug", func(t *testing.T) {
+		_, _, err := schemaService.GetBySlug(ctx, "missing")
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+func TestRename(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("UsedSchemaName", func(t *testing.T) {
+		_, err := schemaService.Rename(ctx, schemaId, "UsedSchemaName")
+
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("Expected ErrAlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("Renamed", func(t *testing.T) {
+		renamed, err := schemaService.Rename(ctx, schemaId, "New Name")
+
+		if err != nil || renamed.SchemaName != "New Name" || renamed.Slug != "new-name" {
+			t.Errorf("Expected the new name and slug, got %+v (%v)", renamed, err)
+		}
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("InvalidTaskGraph", func(t *testing.T) {
+		tasks := []domain.Task{{ID: 1, Status: domain.TaskDone, BlockedBy: []int64{1}}}
+		_, err := schemaService.Update(ctx, schemaId, tasks, false)
+
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("Expected ErrInvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("NotPresentSchemaID", func(t *testing.T) {
+		_, err := schemaService.Update(ctx, "NotPresentSchemaID", []domain.Task{task1}, false)
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("Updated", func(t *testing.T) {
+		tasks := []domain.Task{{ID: 9, Status: domain.TaskDone, Children: []domain.Task{{ID: 4, Status: domain.TaskDone, BlockedBy: []int64{9}}, {Status: domain.TaskDone}}}}
+		updated, err := schemaService.Update(ctx, schemaId, tasks, true)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expected := []domain.Task{{ID: 9, Level: 1, Status: domain.TaskDone, Children: []domain.Task{{ID: 4, Level: 2, Status: domain.TaskDone, BlockedBy: []int64{9}}, {ID: 10, Level: 2, Status: domain.TaskDone}}}}
+		if !reflect.DeepEqual(updated.Tasks, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, updated.Tasks)
+		}
+	})
+
+	t.Run("AssignedIDsKeepV2Fields", func(t *testing.T) {
+		storageService, err := storage.NewStorage("../../providers/storage/test_storage.json", true)
+		if err != nil {
+			t.Fatalf("Failed to create storage: %v", err)
+		}
+		schemaService := &schema.Schema{StorageProvider: storageService}
+		created, err := schemaService.Create(ctx, "authorID", "v2 fields", []domain.Task{
+			{ID: 1, Name: "a", Status: domain.TaskDone, Description: "desc of a", Labels: []string{"a"}},
+			{ID: 2, Name: "b", Status: domain.TaskDone, Description: "desc of b"},
+		}, false)
+		if err != nil {
+			t.Fatalf("Failed to create schema: %v", err)
+		}
+
+		tasks := []domain.Task{{ID: 2, Name: "b", Status: domain.TaskDone}, {ID: 1, Name: "a", Status: domain.TaskDone}}
+		updated, err := schemaService.Update(ctx, created.SchemaID, tasks, true)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		for _, task := range updated.Tasks {
+			if task.Description != "desc of "+task.Name {
+				t.Errorf("Expected task %q to keep its own description, got %+v", task.Name, task)
+			}
+		}
+		if len(updated.Tasks[1].Labels) != 1 || updated.Tasks[1].Labels[0] != "a" {
+			t.Errorf("Expected task a to keep its labels, got %+v", updated.Tasks[1])
+		}
+	})
+}
+
+func TestVersions(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("Publish", func(t *testing.T) {
+		version, err := schemaService.Publish(ctx, schemaId)
+
+		if err != nil || version.Version != 1 {
+			t.Errorf("Expected version 1, got %+v (%v)", version, err)
+		}
+	})
+
+	t.Run("PublishNotPresent", func(t *testing.T) {
+		_, err := schemaService.Publish(ctx, "NotPresentSchemaID")
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		versions, err := schemaService.ListVersions(ctx, schemaId)
+
+		if err != nil || len(versions) != 1 {
+			t.Errorf("Expected one version, got %+v (%v)", versions, err)
+		}
+	})
+
+	t.Run("GetNotPublished", func(t *testing.T) {
+		_, err := schemaService.GetVersion(ctx, schemaId, 2)
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+func TestTransition(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("Transitioned", func(t *testing.T) {
+		moved, err := schemaService.Transition(ctx, schemaId, domain.StateInReview, "")
+
+		if err != nil || moved.State != domain.StateInReview {
+			t.Errorf("Expected the schema to be in review, got %+v (%v)", moved, err)
+		}
+	})
+
+	t.Run("InvalidTransition", func(t *testing.T) {
+		_, err := schemaService.Transition(ctx, schemaId, domain.StateDeprecated, "")
+
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected ErrFailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("NotPresentSchemaID", func(t *testing.T) {
+		_, err := schemaService.Transition(ctx, "NotPresentSchemaID", domain.StateInReview, "")
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+func TestReview(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("RequestReview", func(t *testing.T) {
+		inReview, err := schemaService.RequestReview(ctx, schemaId, "please check")
+
+		if err != nil || inReview.State != domain.StateInReview {
+			t.Errorf("Expected the schema to be in review, got %+v (%v)", inReview, err)
+		}
+	})
+
+	t.Run("Approve", func(t *testing.T) {
+		approved, err := schemaService.Approve(ctx, schemaId, "")
+
+		if err != nil || approved.State != domain.StateApproved {
+			t.Errorf("Expected the schema to be approved, got %+v (%v)", approved, err)
+		}
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		rejected, err := schemaService.Reject(ctx, schemaId, "wrong dose")
+
+		if err != nil || rejected.State != domain.StateDraft {
+			t.Errorf("Expected the schema to be a draft, got %+v (%v)", rejected, err)
+		}
+	})
+
+	t.Run("NotPresentSchemaID", func(t *testing.T) {
+		_, err := schemaService.Approve(ctx, "NotPresentSchemaID", "")
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+func TestDiff(t *testing.T) {
+	mockStorageProvider := &MockStorageProvider{}
+	schemaService := &schema.Schema{StorageProvider: mockStorageProvider}
+
+	t.Run("DraftAgainstVersion", func(t *testing.T) {
+		diff, err := schemaService.Diff(ctx, schemaId, 1, "", 0)
+
+		if err != nil || diff.Text() != "no changes\n" {
+			t.Errorf("Expected no changes, got %+v (%v)", diff, err)
+		}
+	})
+
+	t.Run("NotPublished", func(t *testing.T) {
+		_, err := schemaService.Diff(ctx, schemaId, 0, schemaId, 2)
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("Changes", func(t *testing.T) {
+		from := domainSchema
+		from.Tasks = domain.CloneTasks([]domain.Task{task1, task2})
+		to := domainSchema
+		to.SchemaName = "renamed"
+		edited, moved := task2, task1
+		edited.Name, edited.Status, edited.Children = "Task two", domain.TaskDone, nil
+		moved.Children = []domain.Task{{ID: 4, Name: "Task 4", Status: domain.TaskBlocked}}
+		to.Tasks = []domain.Task{edited, moved}
+
+		diff, err := domain.DiffSchemas(from, to)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expected := domain.SchemaDiff{
+			SchemaChanges: []domain.FieldChange{{Field: "schema_name", Before: `"schemaName"`, After: `"renamed"`}},
+			TaskChanges: []domain.TaskChange{
+				{Kind: domain.ChangeMoved, TaskID: 2, Name: "Task two", Fields: []domain.FieldChange{{Field: "position", Before: "2", After: "1"}}},
+				{Kind: domain.ChangeModified, TaskID: 2, Name: "Task two", Fields: []domain.FieldChange{
+					{Field: "name", Before: `"Task 3"`, After: `"Task two"`},
+					{Field: "status", Before: `"NOT_STARTED"`, After: `"DONE"`},
+				}},
+				{Kind: domain.ChangeMoved, TaskID: 1, Name: "Task 1", Fields: []domain.FieldChange{{Field: "position", Before: "1", After: "2"}}},
+				{Kind: domain.ChangeAdded, TaskID: 4, Name: "Task 4", Fields: []domain.FieldChange{
+					{Field: "parent", After: "1"},
+					{Field: "name", After: `"Task 4"`},
+					{Field: "status", After: `"BLOCKED"`},
+				}},
+				{Kind: domain.ChangeRemoved, TaskID: 3, Name: "Task 2", Fields: []domain.FieldChange{
+					{Field: "parent", Before: "2"},
+					{Field: "name", Before: `"Task 2"`},
+					{Field: "status", Before: `"NOT_STARTED"`},
+					{Field: "responsible", Before: `"Doctor2"`},
+					{Field: "time_limit", Before: "7200"},
+					{Field: "comment", Before: `"Comment for Task 2"`},
+				}},
+			},
+		}
+		if !reflect.DeepEqual(diff, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, diff)
+		}
+
+		expectedText := `~ schema modified
+    schema_name: "schemaName" -> "renamed"
+> task 2 "Task two" moved
+    position: 2 -> 1
+~ task 2 "Task two" modified
+    name: "Task 3" -> "Task two"
+    status: "NOT_STARTED" -> "DONE"
+> task 1 "Task 1" moved
+    position: 1 -> 2
++ task 4 "Task 4" added
+    parent: 1
+    name: "Task 4"
+    status: "BLOCKED"
+- task 3 "Task 2" removed
+    parent: 2
+    name: "Task 2"
+    status: "NOT_STARTED"
+    responsible: "Doctor2"
+    time_limit: 7200
+    comment: "Comment for Task 2"
+`
+		if text := diff.Text(); text != expectedText {
+			t.Errorf("Expected text\n%s\ngot\n%s", expectedText, text)
+		}
+	})
+
+	t.Run("MovedToAnotherParent", func(t *testing.T) {
+		from := domain.Schema{Tasks: []domain.Task{task2}}
+		root := task2
+		root.Children = nil
+		to := domain.Schema{Tasks: []domain.Task{root, task3}}
+
+		diff, err := domain.DiffSchemas(from, to)
+
+		expected := []domain.TaskChange{{Kind: domain.ChangeMoved, TaskID: 3, Name: "Task 2", Fields: []domain.FieldChange{
+			{Field: "parent", Before: "2", After: "null"},
+			{Field: "position", Before: "1", After: "2"},
+		}}}
+		if err != nil || len(diff.SchemaChanges) != 0 || !reflect.DeepEqual(diff.TaskChanges, expected) {
+			t.Errorf("Expected %+v, got %+v (%v)", expected, diff.TaskChanges, err)
+		}
+	})
+
+	t.Run("SiblingsAddedAround", func(t *testing.T) {
+		from := domain.Schema{Tasks: []domain.Task{task1, task2}}
+		to := domain.Schema{Tasks: []domain.Task{{ID: 5, Name: "Task 5"}, task1, task2}}
+
+		diff, err := domain.DiffSchemas(from, to)
+
+		if err != nil || len(diff.TaskChanges) != 1 || diff.TaskChanges[0].Kind != domain.ChangeAdded {
+			t.Errorf("Expected only task 5 to be added, got %+v (%v)", diff.TaskChanges, err)
+		}
+	})
+
+	t.Run("AmbiguousTaskIDs", func(t *testing.T) {
+		_, err := domain.DiffSchemas(domain.Schema{Tasks: []domain.Task{task1, task1}}, domainSchema)
+
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected ErrFailedPrecondition, got %v", err)
+		}
 	})
 }
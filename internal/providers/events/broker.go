@@ -0,0 +1,156 @@
+package events
+
+import (
+	"fmt"
+	"server/internal/domain"
+	"sync"
+)
+
+const (
+	DefaultHistorySize = 1024
+	DefaultBufferSize  = 256
+)
+
+// Broker fans schema events out to subscribers. It keeps the most recent
+// events so that watchers can resume from a past revision.
+type Broker struct {
+	mu          sync.Mutex
+	revision    int64
+	history     []domain.SchemaEvent // ring buffer of the last historyLen events
+	historyLen  int
+	historyPos  int // where the next event is written
+	bufferSize  int
+	subscribers map[*Subscription]struct{}
+}
+
+// NewBroker creates a broker whose feed continues after revision, the
+// current revision of the store.
+func NewBroker(revision int64, historySize int, bufferSize int) *Broker {
+	return &Broker{
+		revision:    revision,
+		history:     make([]domain.SchemaEvent, historySize),
+		bufferSize:  bufferSize,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Revision returns the revision of the last published event.
+func (b *Broker) Revision() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.revision
+}
+
+// Publish records event and delivers it to every matching subscriber.
+// Events must be published with consecutive revisions. Subscribers whose
+// buffer is full are dropped rather than slowing down the publisher.
+func (b *Broker) Publish(event domain.SchemaEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.revision = event.Revision
+
+	if len(b.history) > 0 {
+		b.history[b.historyPos] = event
+		b.historyPos = (b.historyPos + 1) % len(b.history)
+		if b.historyLen < len(b.history) {
+			b.historyLen++
+		}
+	}
+
+	for sub := range b.subscribers {
+		if !sub.filter.Matches(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+			sub.lastRevision = event.Revision
+		default:
+			sub.err = domain.NewError(domain.ErrResourceExhausted, "SLOW_CONSUMER",
+				map[string]string{"last_revision": fmt.Sprint(sub.lastRevision)},
+				"watcher fell behind; resume from revision %d", sub.lastRevision)
+			b.remove(sub)
+		}
+	}
+}
+
+// Subscribe starts a subscription receiving the events matching filter.
+// With fromRevision > 0 the recorded events after that revision are
+// replayed first; it fails when those events are no longer recorded.
+func (b *Broker) Subscribe(fromRevision int64, filter domain.EventFilter) (*Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if fromRevision > b.revision {
+		return nil, domain.NewError(domain.ErrFailedPrecondition, "REVISION_IN_FUTURE",
+			map[string]string{"current_revision": fmt.Sprint(b.revision)},
+			"revision %d is ahead of the current revision %d", fromRevision, b.revision)
+	}
+
+	var replay []domain.SchemaEvent
+	if fromRevision > 0 {
+		oldest := b.revision - int64(b.historyLen) + 1
+		if fromRevision+1 < oldest {
+			return nil, domain.NewError(domain.ErrFailedPrecondition, "REVISION_COMPACTED",
+				map[string]string{"oldest_revision": fmt.Sprint(oldest)},
+				"revision %d is no longer available for watching, oldest available revision is %d; sync with GetChangesSince first", fromRevision, oldest)
+		}
+		for i := 0; i < b.historyLen; i++ {
+			event := b.history[(b.historyPos-b.historyLen+i+len(b.history))%len(b.history)]
+			if event.Revision > fromRevision && filter.Matches(event) {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	sub := &Subscription{
+		broker:       b,
+		filter:       filter,
+		events:       make(chan domain.SchemaEvent, b.bufferSize+len(replay)),
+		lastRevision: fromRevision,
+	}
+	for _, event := range replay {
+		sub.events <- event
+		sub.lastRevision = event.Revision
+	}
+	b.subscribers[sub] = struct{}{}
+
+	return sub, nil
+}
+
+func (b *Broker) remove(sub *Subscription) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.events)
+}
+
+// Subscription implements domain.EventStream.
+type Subscription struct {
+	broker       *Broker
+	filter       domain.EventFilter
+	events       chan domain.SchemaEvent
+	lastRevision int64
+	err          error
+}
+
+func (s *Subscription) Events() <-chan domain.SchemaEvent {
+	return s.events
+}
+
+// Err returns why the subscription was ended by the broker, if it was.
+func (s *Subscription) Err() error {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	return s.err
+}
+
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+
+	s.broker.remove(s)
+}
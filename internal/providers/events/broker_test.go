@@ -0,0 +1,134 @@
+package events_test
+
+import (
+	"errors"
+	"server/internal/domain"
+	"server/internal/providers/events"
+	"testing"
+)
+
+func event(revision int64, schemaID string, authorID string) domain.SchemaEvent {
+	return domain.SchemaEvent{
+		Revision: revision,
+		Type:     domain.EventCreated,
+		SchemaID: schemaID,
+		Schema:   domain.Schema{SchemaID: schemaID, AuthorID: authorID},
+	}
+}
+
+func TestPublish(t *testing.T) {
+	t.Run("Tracks the last revision", func(t *testing.T) {
+		broker := events.NewBroker(5, 10, 10)
+
+		broker.Publish(event(6, "a", "author"))
+
+		if broker.Revision() != 6 {
+			t.Errorf("Expected revision 6, got %d", broker.Revision())
+		}
+	})
+
+	t.Run("Delivers matching events only", func(t *testing.T) {
+		broker := events.NewBroker(0, 10, 10)
+		sub, _ := broker.Subscribe(0, domain.EventFilter{SchemaIDs: []string{"b"}})
+		defer sub.Close()
+
+		broker.Publish(event(1, "a", "author"))
+		broker.Publish(event(2, "b", "author"))
+
+		received := <-sub.Events()
+		if received.SchemaID != "b" || len(sub.Events()) != 0 {
+			t.Errorf("Expected only the event for b, got %+v", received)
+		}
+	})
+
+	t.Run("Drops slow consumers", func(t *testing.T) {
+		broker := events.NewBroker(0, 10, 1)
+		sub, _ := broker.Subscribe(0, domain.EventFilter{})
+
+		broker.Publish(event(1, "a", "author"))
+		broker.Publish(event(2, "b", "author"))
+
+		// The buffered event is still delivered before the channel closes
+		if received := <-sub.Events(); received.Revision != 1 {
+			t.Errorf("Expected revision 1, got %d", received.Revision)
+		}
+		if _, ok := <-sub.Events(); ok {
+			t.Errorf("Expected the subscription to be closed")
+		}
+		if !errors.Is(sub.Err(), domain.ErrResourceExhausted) {
+			t.Errorf("Expected ErrResourceExhausted, got %v", sub.Err())
+		}
+
+		sub.Close() // closing a dropped subscription is a no-op
+	})
+}
+
+func TestSubscribe(t *testing.T) {
+	broker := events.NewBroker(0, 3, 10)
+	for i, id := range []string{"a", "b", "c", "d", "e"} {
+		broker.Publish(event(int64(i+1), id, "author"))
+	}
+
+	t.Run("Replays events after the revision", func(t *testing.T) {
+		sub, err := broker.Subscribe(3, domain.EventFilter{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		defer sub.Close()
+
+		if len(sub.Events()) != 2 {
+			t.Fatalf("Expected 2 replayed events, got %d", len(sub.Events()))
+		}
+		if received := <-sub.Events(); received.SchemaID != "d" {
+			t.Errorf("Expected d first, got %s", received.SchemaID)
+		}
+	})
+
+	t.Run("Oldest recorded revision", func(t *testing.T) {
+		sub, err := broker.Subscribe(2, domain.EventFilter{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		defer sub.Close()
+
+		if len(sub.Events()) != 3 {
+			t.Errorf("Expected 3 replayed events, got %d", len(sub.Events()))
+		}
+	})
+
+	t.Run("Compacted revision", func(t *testing.T) {
+		_, err := broker.Subscribe(1, domain.EventFilter{})
+
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected ErrFailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("Revision in the future", func(t *testing.T) {
+		_, err := broker.Subscribe(6, domain.EventFilter{})
+
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected ErrFailedPrecondition, got %v", err)
+		}
+	})
+}
+
+func TestSubscribeAfterRestart(t *testing.T) {
+	broker := events.NewBroker(7, 3, 10)
+
+	t.Run("Current revision", func(t *testing.T) {
+		sub, err := broker.Subscribe(7, domain.EventFilter{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		sub.Close()
+	})
+
+	t.Run("Revisions before the restart", func(t *testing.T) {
+		_, err := broker.Subscribe(6, domain.EventFilter{})
+
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected ErrFailedPrecondition, got %v", err)
+		}
+	})
+}
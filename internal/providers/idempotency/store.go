@@ -0,0 +1,108 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"server/internal/domain"
+	"sync"
+	"time"
+)
+
+const DefaultTTL = 24 * time.Hour
+
+type entry struct {
+	hash      []byte
+	done      chan struct{} // closed once the first call returned
+	finished  bool          // the first call succeeded and response is set
+	response  any
+	expiresAt time.Time
+}
+
+// Store remembers the outcome of the calls made with an idempotency key so
+// that retries get the original response instead of running again. Keys are
+// kept for ttl after the call that used them first completed.
+type Store struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	entries   map[string]*entry
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewStore(ttl time.Duration) *Store {
+	return &Store{
+		ttl:     ttl,
+		entries: make(map[string]*entry),
+		now:     time.Now,
+	}
+}
+
+// Do runs call unless a call with the same key already succeeded, in which
+// case its response is returned. hash identifies the request payload: reusing
+// a key with another payload fails with AlreadyExists. Concurrent calls
+// with the same key wait for the first one. Failed calls are not remembered,
+// so they can be retried with the same key.
+func (s *Store) Do(ctx context.Context, key string, hash []byte, call func() (any, error)) (any, error) {
+	for {
+		s.mu.Lock()
+		now := s.now()
+		s.sweep(now)
+
+		e, ok := s.entries[key]
+		if ok && (!e.finished || now.Before(e.expiresAt)) {
+			finished, response, done := e.finished, e.response, e.done
+			s.mu.Unlock()
+
+			if !bytes.Equal(e.hash, hash) {
+				return nil, domain.NewError(domain.ErrAlreadyExists, "IDEMPOTENCY_KEY_REUSED", nil,
+					"idempotency key was already used with a different request")
+			}
+			if finished {
+				return response, nil
+			}
+
+			// Wait for the call in flight, then look again: it is gone
+			// if it failed
+			select {
+			case <-done:
+				continue
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		// First call with this key
+		e = &entry{hash: hash, done: make(chan struct{})}
+		s.entries[key] = e
+		s.mu.Unlock()
+
+		response, err := call()
+
+		s.mu.Lock()
+		if err != nil {
+			delete(s.entries, key)
+		} else {
+			e.finished = true
+			e.response = response
+			e.expiresAt = s.now().Add(s.ttl)
+		}
+		close(e.done)
+		s.mu.Unlock()
+
+		return response, err
+	}
+}
+
+// sweep drops expired keys, at most once per ttl. Callers hold the lock.
+func (s *Store) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < s.ttl {
+		return
+	}
+	s.lastSweep = now
+
+	for key, e := range s.entries {
+		if e.finished && !now.Before(e.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+}
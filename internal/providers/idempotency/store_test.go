@@ -0,0 +1,95 @@
+package idempotency_test
+
+import (
+	"context"
+	"errors"
+	"server/internal/domain"
+	"server/internal/providers/idempotency"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDo(t *testing.T) {
+	t.Run("Replays the first response", func(t *testing.T) {
+		store := idempotency.NewStore(time.Hour)
+		calls := 0
+		call := func() (any, error) {
+			calls++
+			return calls, nil
+		}
+
+		first, _ := store.Do(context.Background(), "key", []byte("a"), call)
+		second, err := store.Do(context.Background(), "key", []byte("a"), call)
+
+		if err != nil || first != 1 || second != 1 || calls != 1 {
+			t.Errorf("Expected a single call replayed, got %v, %v (%d calls, %v)", first, second, calls, err)
+		}
+	})
+
+	t.Run("Rejects a different payload", func(t *testing.T) {
+		store := idempotency.NewStore(time.Hour)
+		store.Do(context.Background(), "key", []byte("a"), func() (any, error) { return 1, nil })
+
+		_, err := store.Do(context.Background(), "key", []byte("b"), func() (any, error) { return 2, nil })
+
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("Expected ErrAlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("Forgets failures", func(t *testing.T) {
+		store := idempotency.NewStore(time.Hour)
+		store.Do(context.Background(), "key", []byte("a"), func() (any, error) { return nil, errors.New("unavailable") })
+
+		response, err := store.Do(context.Background(), "key", []byte("a"), func() (any, error) { return 2, nil })
+
+		if err != nil || response != 2 {
+			t.Errorf("Expected the retry to run, got %v (%v)", response, err)
+		}
+	})
+
+	t.Run("Expires keys", func(t *testing.T) {
+		store := idempotency.NewStore(10 * time.Millisecond)
+		store.Do(context.Background(), "key", []byte("a"), func() (any, error) { return 1, nil })
+		time.Sleep(20 * time.Millisecond)
+
+		response, err := store.Do(context.Background(), "key", []byte("b"), func() (any, error) { return 2, nil })
+
+		if err != nil || response != 2 {
+			t.Errorf("Expected the expired key to be reusable, got %v (%v)", response, err)
+		}
+	})
+
+	t.Run("Concurrent duplicates wait for the first call", func(t *testing.T) {
+		store := idempotency.NewStore(time.Hour)
+		var calls int32
+		release := make(chan struct{})
+
+		var wg sync.WaitGroup
+		responses := make([]any, 5)
+		for i := range responses {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				responses[i], _ = store.Do(context.Background(), "key", []byte("a"), func() (any, error) {
+					<-release
+					return atomic.AddInt32(&calls, 1), nil
+				})
+			}(i)
+		}
+		close(release)
+		wg.Wait()
+
+		if calls != 1 {
+			t.Errorf("Expected a single call, got %d", calls)
+		}
+		for _, response := range responses {
+			if response != int32(1) {
+				t.Errorf("Expected every caller to get the first response, got %v", responses)
+				break
+			}
+		}
+	})
+}
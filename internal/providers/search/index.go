@@ -0,0 +1,212 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"server/internal/domain"
+)
+
+// Relative weight of a term occurrence depending on where it was found.
+var fieldWeights = map[domain.SearchField]float64{
+	domain.SearchFieldSchemaName:  3,
+	domain.SearchFieldTaskName:    2,
+	domain.SearchFieldTaskComment: 1,
+}
+
+// Prefix matches on the last query term score lower than exact matches.
+const prefixPenalty = 0.5
+
+const snippetMaxBytes = 160
+
+type field struct {
+	kind     domain.SearchField
+	taskPath []int64
+	text     string
+	tokens   []Token
+}
+
+type document struct {
+	fields []field
+	terms  map[string]float64 // term -> weighted frequency
+}
+
+type Hit struct {
+	SchemaID string
+	Score    float64
+	Matches  []domain.SearchMatch
+}
+
+// Index is an in-memory inverted index over schema names, task names and
+// task comments. It is not safe for concurrent use; callers synchronize.
+type Index struct {
+	docs     map[string]*document
+	postings map[string]map[string]struct{} // term -> schema ids
+}
+
+func NewIndex() *Index {
+	return &Index{
+		docs:     make(map[string]*document),
+		postings: make(map[string]map[string]struct{}),
+	}
+}
+
+// Add indexes schema, replacing any previous version with the same id.
+func (idx *Index) Add(schema domain.Schema) {
+	idx.Remove(schema.SchemaID)
+
+	doc := &document{terms: make(map[string]float64)}
+	doc.addField(domain.SearchFieldSchemaName, nil, schema.SchemaName)
+	doc.addTasks(nil, schema.Tasks)
+
+	for term := range doc.terms {
+		ids, ok := idx.postings[term]
+		if !ok {
+			ids = make(map[string]struct{})
+			idx.postings[term] = ids
+		}
+		ids[schema.SchemaID] = struct{}{}
+	}
+	idx.docs[schema.SchemaID] = doc
+}
+
+func (doc *document) addTasks(parentPath []int64, tasks []domain.Task) {
+	for _, task := range tasks {
+		path := append(append([]int64{}, parentPath...), int64(task.ID))
+		doc.addField(domain.SearchFieldTaskName, path, task.Name)
+		doc.addField(domain.SearchFieldTaskComment, path, task.Comment.Value)
+		doc.addTasks(path, task.Children)
+	}
+}
+
+func (doc *document) addField(kind domain.SearchField, taskPath []int64, text string) {
+	tokens := Tokenize(text)
+	if len(tokens) == 0 {
+		return
+	}
+	doc.fields = append(doc.fields, field{kind: kind, taskPath: taskPath, text: text, tokens: tokens})
+	for _, token := range tokens {
+		doc.terms[token.Term] += fieldWeights[kind]
+	}
+}
+
+// Remove drops the schema with the given id from the index, if present.
+func (idx *Index) Remove(schemaID string) {
+	doc, ok := idx.docs[schemaID]
+	if !ok {
+		return
+	}
+	for term := range doc.terms {
+		ids := idx.postings[term]
+		delete(ids, schemaID)
+		if len(ids) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+	delete(idx.docs, schemaID)
+}
+
+// Search returns up to limit schemas containing every term of query, ordered
+// by descending score. The last query term also matches as a prefix so that
+// partially typed words find results.
+func (idx *Index) Search(query string, limit int) []Hit {
+	queryTerms := uniqueTerms(Tokenize(query))
+	if len(queryTerms) == 0 {
+		return nil
+	}
+
+	// Expand every query term to the indexed terms it matches and their weight
+	expansions := make([]map[string]float64, len(queryTerms))
+	for i, queryTerm := range queryTerms {
+		expansions[i] = make(map[string]float64)
+		if _, ok := idx.postings[queryTerm]; ok {
+			expansions[i][queryTerm] = 1
+		}
+		if i == len(queryTerms)-1 {
+			for term := range idx.postings {
+				if term != queryTerm && strings.HasPrefix(term, queryTerm) {
+					expansions[i][term] = prefixPenalty
+				}
+			}
+		}
+		if len(expansions[i]) == 0 {
+			return nil
+		}
+	}
+
+	var hits []Hit
+	for schemaID, doc := range idx.docs {
+		score := 0.0
+		matched := make(map[string]struct{})
+		for _, expansion := range expansions {
+			termScore := 0.0
+			for term, weight := range expansion {
+				frequency, ok := doc.terms[term]
+				if !ok {
+					continue
+				}
+				termScore += weight * frequency * idx.idf(term)
+				matched[term] = struct{}{}
+			}
+			if termScore == 0 {
+				score = 0
+				break
+			}
+			score += termScore
+		}
+		if score == 0 {
+			continue
+		}
+		hits = append(hits, Hit{SchemaID: schemaID, Score: score, Matches: doc.matches(matched)})
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].SchemaID < hits[j].SchemaID
+	})
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
+
+func (idx *Index) idf(term string) float64 {
+	return math.Log(1 + float64(len(idx.docs))/float64(len(idx.postings[term])))
+}
+
+func (doc *document) matches(terms map[string]struct{}) []domain.SearchMatch {
+	var matches []domain.SearchMatch
+	for _, f := range doc.fields {
+		var spans []Token
+		for _, token := range f.tokens {
+			if _, ok := terms[token.Term]; ok {
+				spans = append(spans, token)
+			}
+		}
+		if len(spans) == 0 {
+			continue
+		}
+		matches = append(matches, domain.SearchMatch{
+			Field:    f.kind,
+			TaskPath: f.taskPath,
+			Snippet:  Highlight(f.text, spans, snippetMaxBytes),
+		})
+	}
+	return matches
+}
+
+func uniqueTerms(tokens []Token) []string {
+	seen := make(map[string]struct{})
+	var terms []string
+	for _, token := range tokens {
+		if _, ok := seen[token.Term]; ok {
+			continue
+		}
+		seen[token.Term] = struct{}{}
+		terms = append(terms, token.Term)
+	}
+	return terms
+}
@@ -0,0 +1,157 @@
+package search_test
+
+import (
+	"reflect"
+	"server/internal/domain"
+	"server/internal/providers/search"
+	"testing"
+)
+
+func newTask(id int, name string, comment string, children ...domain.Task) domain.Task {
+	task := domain.Task{ID: id, Name: name, Children: children}
+	task.Comment.Value = comment
+	return task
+}
+
+var sepsis domain.Schema = domain.Schema{
+	SchemaID:   "sepsis",
+	SchemaName: "Sepsis protocol",
+	Tasks: []domain.Task{
+		newTask(1, "Blood cultures", "Before antibiotics"),
+		newTask(2, "Antibiotics", "", newTask(3, "Réévaluation", "Check lactate after 6 hours")),
+	},
+}
+
+var stroke domain.Schema = domain.Schema{
+	SchemaID:   "stroke",
+	SchemaName: "Stroke pathway",
+	Tasks: []domain.Task{
+		newTask(1, "CT scan", "Rule out bleeding"),
+		newTask(2, "Thrombolysis", "Check blood pressure"),
+	},
+}
+
+func TestTokenize(t *testing.T) {
+	t.Run("Folds case and accents", func(t *testing.T) {
+		tokens := search.Tokenize("Réévaluation, J+1")
+
+		var terms []string
+		for _, token := range tokens {
+			terms = append(terms, token.Term)
+		}
+
+		if !reflect.DeepEqual(terms, []string{"reevaluation", "j", "1"}) {
+			t.Errorf("Unexpected terms %v", terms)
+		}
+
+		if tokens[0].Start != 0 || tokens[0].End != len("Réévaluation") {
+			t.Errorf("Unexpected span %+v", tokens[0])
+		}
+	})
+}
+
+func TestSearch(t *testing.T) {
+	index := search.NewIndex()
+	index.Add(sepsis)
+	index.Add(stroke)
+
+	t.Run("All terms must match", func(t *testing.T) {
+		hits := index.Search("blood lactate", 10)
+
+		if len(hits) != 1 || hits[0].SchemaID != "sepsis" {
+			t.Errorf("Expected only sepsis, got %+v", hits)
+		}
+	})
+
+	t.Run("Reports nested task path and snippet", func(t *testing.T) {
+		hits := index.Search("reevaluation", 10)
+
+		if len(hits) != 1 {
+			t.Fatalf("Expected 1 hit, got %d", len(hits))
+		}
+
+		expected := domain.SearchMatch{
+			Field:    domain.SearchFieldTaskName,
+			TaskPath: []int64{2, 3},
+			Snippet:  "<em>Réévaluation</em>",
+		}
+		if !reflect.DeepEqual(hits[0].Matches, []domain.SearchMatch{expected}) {
+			t.Errorf("Expected %+v, got %+v", expected, hits[0].Matches)
+		}
+	})
+
+	t.Run("Schema name ranks above comments", func(t *testing.T) {
+		index := search.NewIndex()
+		index.Add(domain.Schema{SchemaID: "a", SchemaName: "Other", Tasks: []domain.Task{newTask(1, "x", "sepsis")}})
+		index.Add(sepsis)
+
+		hits := index.Search("sepsis", 10)
+
+		if len(hits) != 2 || hits[0].SchemaID != "sepsis" {
+			t.Errorf("Expected sepsis first, got %+v", hits)
+		}
+	})
+
+	t.Run("Last term matches as prefix", func(t *testing.T) {
+		hits := index.Search("thromb", 10)
+
+		if len(hits) != 1 || hits[0].SchemaID != "stroke" {
+			t.Errorf("Expected stroke, got %+v", hits)
+		}
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		hits := index.Search("check", 1)
+
+		if len(hits) != 1 {
+			t.Errorf("Expected 1 hit, got %d", len(hits))
+		}
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		index := search.NewIndex()
+		index.Add(stroke)
+		index.Remove("stroke")
+
+		if hits := index.Search("stroke", 10); len(hits) != 0 {
+			t.Errorf("Expected no hits, got %+v", hits)
+		}
+	})
+}
+
+func TestHighlight(t *testing.T) {
+	text := "Administer fluids and reassess the patient every hour until the lactate normalises"
+	tokens := search.Tokenize(text)
+
+	var spans []search.Token
+	for _, token := range tokens {
+		if token.Term == "lactate" {
+			spans = append(spans, token)
+		}
+	}
+
+	snippet := search.Highlight(text, spans, 40)
+
+	if snippet != "…until the <em>lactate</em> normalises" {
+		t.Errorf("Unexpected snippet %q", snippet)
+	}
+}
+
+func TestHighlightEscapesHTML(t *testing.T) {
+	index := search.NewIndex()
+	index.Add(domain.Schema{SchemaID: "xss", SchemaName: "Emergency", Tasks: []domain.Task{
+		newTask(1, `<script>alert("x")</script> & triage`, ""),
+	}})
+
+	hits := index.Search("triage alert", 10)
+
+	if len(hits) != 1 || len(hits[0].Matches) != 1 || hits[0].Matches[0].Field != domain.SearchFieldTaskName {
+		t.Fatalf("Expected a task name match, got %+v", hits)
+	}
+	if snippet := hits[0].Matches[0].Snippet; snippet != `&lt;script&gt;<em>alert</em>(&#34;x&#34;)&lt;/script&gt; &amp; <em>triage</em>` {
+		t.Errorf("Unexpected snippet %q", snippet)
+	}
+	if cropped := search.Highlight("<b>bold</b>", nil, 200); cropped != "&lt;b&gt;bold&lt;/b&gt;" {
+		t.Errorf("Unexpected snippet without spans %q", cropped)
+	}
+}
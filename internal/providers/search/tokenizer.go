@@ -0,0 +1,108 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"server/internal/domain"
+)
+
+// Token is a normalized term together with its byte span in the original text.
+type Token struct {
+	Term  string
+	Start int
+	End   int
+}
+
+// Tokenize splits text into runs of letters and digits and folds every run
+// with domain.Fold. Spans point into the original text so callers can
+// highlight it.
+func Tokenize(text string) []Token {
+	var tokens []Token
+	start := -1
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = appendToken(tokens, text, start, i)
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = appendToken(tokens, text, start, len(text))
+	}
+	return tokens
+}
+
+func appendToken(tokens []Token, text string, start int, end int) []Token {
+	term := domain.Fold(text[start:end])
+	if term == "" {
+		return tokens
+	}
+	return append(tokens, Token{Term: term, Start: start, End: end})
+}
+
+// Highlight wraps the given spans of text in <em></em>, escaping the text
+// itself as HTML. When the text is longer than maxBytes it is cropped around
+// the first span.
+func Highlight(text string, spans []Token, maxBytes int) string {
+	if len(spans) == 0 {
+		return crop(text, maxBytes)
+	}
+
+	from, to := 0, len(text)
+	if len(text) > maxBytes {
+		from = spans[0].Start - maxBytes/4
+		if from < 0 {
+			from = 0
+		}
+		to = from + maxBytes
+		if to > len(text) {
+			to = len(text)
+		}
+		from = alignRune(text, from)
+		to = alignRune(text, to)
+	}
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("…")
+	}
+	pos := from
+	for _, span := range spans {
+		if span.Start < pos || span.End > to {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[pos:span.Start]))
+		b.WriteString("<em>")
+		b.WriteString(html.EscapeString(text[span.Start:span.End]))
+		b.WriteString("</em>")
+		pos = span.End
+	}
+	b.WriteString(html.EscapeString(text[pos:to]))
+	if to < len(text) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+func crop(text string, maxBytes int) string {
+	if len(text) <= maxBytes {
+		return html.EscapeString(text)
+	}
+	return html.EscapeString(text[:alignRune(text, maxBytes)]) + "…"
+}
+
+// alignRune moves i back to the start of the rune it points into.
+func alignRune(text string, i int) int {
+	for i > 0 && i < len(text) && !utf8.RuneStart(text[i]) {
+		i--
+	}
+	return i
+}
@@ -1,22 +1,41 @@
 package storage
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"server/internal/domain"
+	"server/internal/providers/events"
+	"server/internal/providers/search"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// Storage keeps the schemas in memory, saved to a JSON file on every change.
+// Operations fail with the context error when their context is done by the
+// time they hold the lock; writes once started are completed.
 type Storage struct {
+	mu              sync.RWMutex
 	filePath        string
 	schemas         map[string]domain.Schema
+	tombstones      map[string]domain.Tombstone
+	slugs           map[string]string // current and former slugs of live schemas -> schema id
+	revision        int64             // global logical revision, incremented on every change
+	index           *search.Index
+	events          *events.Broker
+	reviewPolicy    domain.ReviewPolicy
 	avoidSavingFile bool
 }
 
+const defaultChangesLimit = 1000
+
 func NewStorage(filePath string, avoidSavingFile bool) (*Storage, error) {
 	// Check if the file exists
 	if _, err := os.Stat(filePath); os.IsNotExist(err) {
@@ -31,22 +50,213 @@ func NewStorage(filePath string, avoidSavingFile bool) (*Storage, error) {
 		return nil, fmt.Errorf("error reading storage file: %v", err)
 	}
 
+	// Task statuses were free-form before they were typed, and the
+	// strict parsing of the domain would refuse them
+	original := data
+	data, statusesMigrated, err := migrateTaskStatuses(data)
+	if err != nil {
+		return nil, fmt.Errorf("error migrating task statuses: %v", err)
+	}
+
 	var schemas []domain.Schema
 	err = json.Unmarshal(data, &schemas)
 	if err != nil {
 		return nil, fmt.Errorf("error unmarshalling JSON: %v", err)
 	}
 
-	schemasMap := make(map[string]domain.Schema)
+	s := &Storage{
+		filePath:        filePath,
+		schemas:         make(map[string]domain.Schema),
+		tombstones:      make(map[string]domain.Tombstone),
+		slugs:           make(map[string]string),
+		index:           search.NewIndex(),
+		avoidSavingFile: avoidSavingFile,
+	}
+
+	// Records written before revisions existed get one assigned after the
+	// highest stored revision, in the order they were last changed
+	migrated := assignMissingRevisions(schemas) || statusesMigrated
+
 	for _, schema := range schemas {
-		schemasMap[schema.SchemaID] = schema
+		if schema.Revision > s.revision {
+			s.revision = schema.Revision
+		}
+		if !schema.DeletedAt.IsZero() {
+			s.tombstones[schema.SchemaID] = domain.Tombstone{
+				SchemaID:  schema.SchemaID,
+				Revision:  schema.Revision,
+				DeletedAt: schema.DeletedAt,
+				ParentID:  schema.ParentID,
+				Versions:  schema.Versions,
+			}
+			continue
+		}
+		s.schemas[schema.SchemaID] = schema
+		s.index.Add(schema)
+		s.indexSlugs(schema)
 	}
+	s.events = events.NewBroker(s.revision, events.DefaultHistorySize, events.DefaultBufferSize)
 
-	return &Storage{
-		filePath:        filePath,
-		schemas:         schemasMap,
-		avoidSavingFile: avoidSavingFile,
-	}, nil
+	// Schemas written before slugs existed get one, in creation order
+	if s.assignMissingSlugs() {
+		migrated = true
+	}
+
+	// Schemas written before the lifecycle are drafts, or published when
+	// their draft is their last version
+	if s.assignMissingStates() {
+		migrated = true
+	}
+
+	if migrated {
+		if err := s.backUp(original); err != nil {
+			return nil, fmt.Errorf("error backing up storage before migration: %v", err)
+		}
+		if err := s.SaveToFile(); err != nil {
+			return nil, fmt.Errorf("error saving migrated storage: %v", err)
+		}
+	}
+
+	return s, nil
+}
+
+func assignMissingRevisions(schemas []domain.Schema) bool {
+	var revision int64
+	var missing []int
+	for i, schema := range schemas {
+		if schema.Revision > revision {
+			revision = schema.Revision
+		}
+		if schema.Revision == 0 {
+			missing = append(missing, i)
+		}
+	}
+
+	sort.SliceStable(missing, func(i, j int) bool {
+		a, b := schemas[missing[i]], schemas[missing[j]]
+		if !a.UpdatedAt.Equal(b.UpdatedAt) {
+			return a.UpdatedAt.Before(b.UpdatedAt)
+		}
+		return a.SchemaID < b.SchemaID
+	})
+	for _, i := range missing {
+		revision++
+		schemas[i].Revision = revision
+	}
+
+	return len(missing) > 0
+}
+
+// migrateTaskStatuses rewrites the statuses of the stored tasks that are not
+// exactly one of the task statuses, telling whether any was. Names differing
+// only in case, separators or the TASK_STATUS_ prefix are normalized to the
+// canonical status, and legacyStatusAliases are replaced. Any other status
+// fails the migration rather than being guessed.
+func migrateTaskStatuses(data []byte) ([]byte, bool, error) {
+	var schemas []map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&schemas); err != nil {
+		return nil, false, err
+	}
+
+	migrated := false
+	var migrate func(schemaID any, tasks any) error
+	migrate = func(schemaID any, tasks any) error {
+		list, _ := tasks.([]any)
+		for _, item := range list {
+			task, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			name, _ := task["status"].(string)
+			if _, err := domain.ParseTaskStatus(name); err != nil {
+				status, ok := legacyTaskStatus(name)
+				if !ok {
+					return fmt.Errorf("schema %v, task %v: unknown status %q", schemaID, task["id"], name)
+				}
+				log.Printf("Migrating task status %q to %s", name, status)
+				task["status"] = string(status)
+				migrated = true
+			}
+			if err := migrate(schemaID, task["children"]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, schema := range schemas {
+		if err := migrate(schema["schema_id"], schema["tasks"]); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if !migrated {
+		return data, false, nil
+	}
+	data, err := json.Marshal(schemas)
+	return data, true, err
+}
+
+// legacyStatusAliases maps the stored statuses that are not the name of a
+// status to the status they are migrated to. Earlier versions stored
+// UNSPECIFIED for tasks created without a status.
+var legacyStatusAliases = map[string]domain.TaskStatus{
+	"UNSPECIFIED": domain.TaskNotStarted,
+}
+
+func legacyTaskStatus(name string) (domain.TaskStatus, bool) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	name = strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+	name = strings.TrimPrefix(name, "TASK_STATUS_")
+	if status, err := domain.ParseTaskStatus(name); err == nil {
+		return status, true
+	}
+	status, ok := legacyStatusAliases[name]
+	return status, ok
+}
+
+func (s *Storage) assignMissingSlugs() bool {
+	var missing []domain.Schema
+	for _, schema := range s.schemas {
+		if schema.Slug == "" {
+			missing = append(missing, schema)
+		}
+	}
+	sortByCreation(missing)
+	for _, schema := range missing {
+		schema.Slug = s.newSlug(schema.SchemaName, schema.SchemaID)
+		s.schemas[schema.SchemaID] = schema
+		s.indexSlugs(schema)
+	}
+
+	return len(missing) > 0
+}
+
+func (s *Storage) assignMissingStates() bool {
+	migrated := false
+	for id, schema := range s.schemas {
+		if schema.State != "" {
+			continue
+		}
+		schema.State = domain.StateDraft
+		if schema.DraftPublished() {
+			schema.State = domain.StatePublished
+		}
+		s.schemas[id] = schema
+		migrated = true
+	}
+	return migrated
+}
+
+// backUp copies the storage file as read, before it is first rewritten by a
+// migration, next to it with the time in its name.
+func (s *Storage) backUp(original []byte) error {
+	if s.avoidSavingFile {
+		return nil
+	}
+	path := fmt.Sprintf("%s.%s.bak", s.filePath, time.Now().Format("20060102T150405"))
+	return os.WriteFile(path, original, 0644)
 }
 
 func (s *Storage) SaveToFile() error {
@@ -54,10 +264,23 @@ func (s *Storage) SaveToFile() error {
 		return nil
 	}
 
-	schemasSlice := make([]domain.Schema, 0, len(s.schemas))
+	// Tombstones are stored as schema records with deleted_at set
+	schemasSlice := make([]domain.Schema, 0, len(s.schemas)+len(s.tombstones))
 	for _, schema := range s.schemas {
 		schemasSlice = append(schemasSlice, schema)
 	}
+	for _, tombstone := range s.tombstones {
+		schemasSlice = append(schemasSlice, domain.Schema{
+			SchemaID:  tombstone.SchemaID,
+			DeletedAt: tombstone.DeletedAt,
+			Revision:  tombstone.Revision,
+			ParentID:  tombstone.ParentID,
+			Versions:  tombstone.Versions,
+		})
+	}
+	sort.Slice(schemasSlice, func(i, j int) bool {
+		return schemasSlice[i].Revision < schemasSlice[j].Revision
+	})
 
 	data, err := json.MarshalIndent(schemasSlice, "", "    ")
 	if err != nil {
@@ -72,6 +295,35 @@ func (s *Storage) SaveToFile() error {
 	return nil
 }
 
+// SetReviewPolicy sets the policy of the reviews requested from now on.
+func (s *Storage) SetReviewPolicy(policy domain.ReviewPolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reviewPolicy = policy
+}
+
+// Backend describes where the schemas are stored.
+func (s *Storage) Backend() string {
+	return "json-file:" + s.filePath
+}
+
+// Ready reports whether the storage can serve requests: it is loaded once
+// created, so this checks that the file can still be written.
+func (s *Storage) Ready() error {
+	if s.avoidSavingFile {
+		return nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	file, err := os.OpenFile(s.filePath, os.O_WRONLY, 0)
+	if err != nil {
+		return fmt.Errorf("storage file is not writable: %v", err)
+	}
+	return file.Close()
+}
+
 func createEmptyJSONFile(filePath string) error {
 	emptyData := []byte("[]") // JSON representation for empty array
 	err := os.WriteFile(filePath, emptyData, 0644)
@@ -81,53 +333,117 @@ func createEmptyJSONFile(filePath string) error {
 	return nil
 }
 
-func (s *Storage) CreateSchema(authorID string, schemaName string, tasks []domain.Task) (domain.Schema, error) {
+func (s *Storage) CreateSchema(ctx context.Context, authorID string, schemaName string, tasks []domain.Task) (domain.Schema, error) {
 	fmt.Println("START Storage.CreateSchema")
 
-	// Check if SchemaName is already used
-	for _, existingSchema := range s.schemas {
-		if existingSchema.SchemaName == schemaName {
-			return domain.Schema{}, fmt.Errorf("schema with name '%s' already exists", schemaName)
-		}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return domain.Schema{}, err
 	}
 
-	// Generate SchemaID
-	id := uuid.New().String()
-	for { // to avoid (really improbable) collisions
-		if _, ok := s.schemas[id]; !ok {
-			break
-		}
-		id = uuid.New().String()
+	schema, err := s.insertSchema(ctx, domain.Schema{
+		AuthorID:   authorID,
+		SchemaName: schemaName,
+		Tasks:      tasks,
+	})
+	if err != nil {
+		return domain.Schema{}, err
 	}
 
-	// Create Schema
-	schema := domain.Schema{
-		SchemaID:   id,
+	fmt.Println("END Storage.CreateSchema")
+	return schema, nil
+}
+
+func (s *Storage) CloneSchema(ctx context.Context, sourceID string, authorID string, schemaName string, renumberTasks bool) (domain.Schema, error) {
+	fmt.Println("START Storage.CloneSchema")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return domain.Schema{}, err
+	}
+
+	// Get source schema and check existance
+	source, ok := s.schemas[sourceID]
+	if !ok {
+		return domain.Schema{}, domain.SchemaNotFoundError(sourceID)
+	}
+
+	// Copy the tasks so that the clone shares nothing with its source
+	tasks := domain.CloneTasks(source.Tasks)
+	if renumberTasks {
+		domain.RenumberTasks(tasks)
+	}
+
+	schema, err := s.insertSchema(ctx, domain.Schema{
 		AuthorID:   authorID,
 		SchemaName: schemaName,
-		CreatedAt:  time.Now(),
-		UpdatedAt:  time.Now(),
 		Tasks:      tasks,
+		ParentID:   sourceID,
+	})
+	if err != nil {
+		return domain.Schema{}, err
+	}
+
+	fmt.Println("END Storage.CloneSchema")
+	return schema, nil
+}
+
+// insertSchema stores a new schema under a fresh id and revision, saves the
+// store and notifies watchers. Callers hold the write lock.
+func (s *Storage) insertSchema(ctx context.Context, schema domain.Schema) (domain.Schema, error) {
+	// Check if SchemaName is already used
+	for _, existingSchema := range s.schemas {
+		if existingSchema.SchemaName == schema.SchemaName {
+			return domain.Schema{}, domain.SchemaNameTakenError(schema.SchemaName)
+		}
 	}
 
+	// Create Schema
+	id := s.newSchemaID()
+	s.revision++
+	schema.SchemaID = id
+	schema.CreatedAt = time.Now()
+	schema.UpdatedAt = time.Now()
+	schema.Revision = s.revision
+	schema.Slug = s.newSlug(schema.SchemaName, id)
+	schema.State = domain.StateDraft
+
 	// Store in the storage
 	s.schemas[id] = schema
+	s.index.Add(schema)
+	s.indexSlugs(schema)
 
 	// Save database
 	err := s.SaveToFile()
 	if err != nil {
 		delete(s.schemas, id) // revert changes to avoid broken state
-		log.Fatalf("error saving storage to file: %v", err)
-		return domain.Schema{}, fmt.Errorf("internal error while creation")
+		s.index.Remove(id)
+		s.unindexSlugs(schema)
+		s.revision--
+		log.Printf("request %s: error saving storage to file: %v", domain.RequestInfoFrom(ctx).RequestID, err)
+		return domain.Schema{}, domain.InternalError("STORAGE_WRITE_FAILED", "internal error while creation")
 	}
 
-	fmt.Println("END Storage.CreateSchema")
+	// Notify watchers
+	s.publish(domain.EventCreated, schema)
+
 	return schema, nil
 }
 
-func (s *Storage) GetAllSchemas() ([]domain.Schema, error) {
+func (s *Storage) GetAllSchemas(ctx context.Context) ([]domain.Schema, error) {
 	fmt.Println("START Storage.GetAllSchemas")
 
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	// Convert schemas to array
 	var schemas []domain.Schema
 	for _, schema := range s.schemas {
@@ -138,39 +454,1035 @@ func (s *Storage) GetAllSchemas() ([]domain.Schema, error) {
 	return schemas, nil
 }
 
-func (s *Storage) GetSchemaByID(id string) (domain.Schema, error) {
+// ExportSchemas returns the schemas matching filter as of the current
+// revision. Stored schemas are replaced rather than modified, so the returned
+// copies stay consistent while the store changes.
+func (s *Storage) ExportSchemas(ctx context.Context, filter domain.ExportFilter) (domain.Snapshot, error) {
+	fmt.Println("START Storage.ExportSchemas")
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if err := ctx.Err(); err != nil {
+		return domain.Snapshot{}, err
+	}
+
+	snapshot := domain.Snapshot{Revision: s.revision}
+	for _, schema := range s.schemas {
+		if filter.Matches(schema) {
+			snapshot.Schemas = append(snapshot.Schemas, schema)
+		}
+	}
+	sortByCreation(snapshot.Schemas)
+
+	fmt.Println("END Storage.ExportSchemas")
+	return snapshot, nil
+}
+
+func (s *Storage) GetSchemaByID(ctx context.Context, id string) (domain.Schema, error) {
 	fmt.Println("START Storage.GetSchemaByID")
 
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if err := ctx.Err(); err != nil {
+		return domain.Schema{}, err
+	}
+
 	// Get schema and check existance
 	schema, ok := s.schemas[id]
 	if !ok {
-		return domain.Schema{}, fmt.Errorf("schema with id=<%s> not found", id)
+		return domain.Schema{}, domain.SchemaNotFoundError(id)
 	}
 
 	fmt.Println("END Storage.GetSchemaByID")
 	return schema, nil
 }
 
-func (s *Storage) DeleteSchemaByID(id string) error {
+func (s *Storage) GetSchemaByName(ctx context.Context, name string) (domain.Schema, error) {
+	fmt.Println("START Storage.GetSchemaByName")
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if err := ctx.Err(); err != nil {
+		return domain.Schema{}, err
+	}
+
+	// Names are unique among live schemas
+	for _, schema := range s.schemas {
+		if schema.SchemaName == name {
+			fmt.Println("END Storage.GetSchemaByName")
+			return schema, nil
+		}
+	}
+
+	return domain.Schema{}, domain.SchemaNameNotFoundError(name)
+}
+
+func (s *Storage) GetSchemasByAuthor(ctx context.Context, authorID string) ([]domain.Schema, error) {
+	fmt.Println("START Storage.GetSchemasByAuthor")
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	schemas := []domain.Schema{}
+	for _, schema := range s.schemas {
+		if schema.AuthorID == authorID {
+			schemas = append(schemas, schema)
+		}
+	}
+	sortByCreation(schemas)
+
+	fmt.Println("END Storage.GetSchemasByAuthor")
+	return schemas, nil
+}
+
+// GetSchemaBySlug resolves the current or a former slug of a live schema,
+// reporting whether it is a former one.
+func (s *Storage) GetSchemaBySlug(ctx context.Context, slug string) (domain.Schema, bool, error) {
+	fmt.Println("START Storage.GetSchemaBySlug")
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if err := ctx.Err(); err != nil {
+		return domain.Schema{}, false, err
+	}
+
+	id, ok := s.slugs[slug]
+	if !ok {
+		return domain.Schema{}, false, domain.SlugNotFoundError(slug)
+	}
+	schema := s.schemas[id]
+
+	fmt.Println("END Storage.GetSchemaBySlug")
+	return schema, schema.Slug != slug, nil
+}
+
+// RenameSchema gives a schema a new name and the matching slug, keeping its
+// former slug as an alias. Renaming a schema to its current name changes
+// nothing.
+func (s *Storage) RenameSchema(ctx context.Context, id string, name string) (domain.Schema, error) {
+	fmt.Println("START Storage.RenameSchema")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return domain.Schema{}, err
+	}
+
+	// Get schema and check existance
+	previous, ok := s.schemas[id]
+	if !ok {
+		return domain.Schema{}, domain.SchemaNotFoundError(id)
+	}
+	if previous.SchemaName == name {
+		fmt.Println("END Storage.RenameSchema")
+		return previous, nil
+	}
+	for _, existingSchema := range s.schemas {
+		if existingSchema.SchemaName == name {
+			return domain.Schema{}, domain.SchemaNameTakenError(name)
+		}
+	}
+
+	// Rename, reclaiming the new slug if it is a former one
+	now := time.Now()
+	schema, err := s.editDraft(ctx, previous, now)
+	if err != nil {
+		return domain.Schema{}, err
+	}
+	s.revision++
+	schema.SchemaName = name
+	schema.UpdatedAt = now
+	schema.Revision = s.revision
+	schema.Slug = s.newSlug(name, id)
+	if schema.Slug != previous.Slug {
+		schema.SlugAliases = []string{}
+		for _, alias := range previous.SlugAliases {
+			if alias != schema.Slug {
+				schema.SlugAliases = append(schema.SlugAliases, alias)
+			}
+		}
+		schema.SlugAliases = append(schema.SlugAliases, previous.Slug)
+	}
+	s.schemas[id] = schema
+	s.index.Add(schema)
+	s.indexSlugs(schema)
+
+	// Save database
+	err = s.SaveToFile()
+	if err != nil {
+		s.schemas[id] = previous // revert changes to avoid broken state
+		s.index.Add(previous)
+		s.unindexSlugs(schema)
+		s.indexSlugs(previous)
+		s.revision--
+		log.Printf("request %s: error saving storage to file: %v", domain.RequestInfoFrom(ctx).RequestID, err)
+		return domain.Schema{}, domain.InternalError("STORAGE_WRITE_FAILED", "internal error while renaming")
+	}
+
+	// Notify watchers
+	s.publish(domain.EventUpdated, schema)
+
+	fmt.Println("END Storage.RenameSchema")
+	return schema, nil
+}
+
+// UpdateSchema replaces the tasks of the draft of a schema, numbering them
+// from 1 with assignTaskIDs. Its published versions are left as they are.
+func (s *Storage) UpdateSchema(ctx context.Context, id string, tasks []domain.Task, assignTaskIDs bool) (domain.Schema, error) {
+	fmt.Println("START Storage.UpdateSchema")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return domain.Schema{}, err
+	}
+
+	// Get schema and check existance
+	previous, ok := s.schemas[id]
+	if !ok {
+		return domain.Schema{}, domain.SchemaNotFoundError(id)
+	}
+
+	// Update the draft, which is no longer reviewed or published
+	now := time.Now()
+	schema, err := s.editDraft(ctx, previous, now)
+	if err != nil {
+		return domain.Schema{}, err
+	}
+	s.revision++
+	schema.Tasks = domain.CloneTasks(tasks)
+	domain.KeepV2Fields(schema.Tasks, previous.Tasks) // updates come from version 1, matched by the ids sent
+	if assignTaskIDs {
+		domain.AssignTaskIDs(schema.Tasks)
+	}
+	schema.UpdatedAt = now
+	schema.Revision = s.revision
+	s.schemas[id] = schema
+	s.index.Add(schema)
+
+	// Save database
+	err = s.SaveToFile()
+	if err != nil {
+		s.schemas[id] = previous // revert changes to avoid broken state
+		s.index.Add(previous)
+		s.revision--
+		log.Printf("request %s: error saving storage to file: %v", domain.RequestInfoFrom(ctx).RequestID, err)
+		return domain.Schema{}, domain.InternalError("STORAGE_WRITE_FAILED", "internal error while updating")
+	}
+
+	// Notify watchers
+	s.publish(domain.EventUpdated, schema)
+
+	fmt.Println("END Storage.UpdateSchema")
+	return schema, nil
+}
+
+// PublishSchema moves a schema to PUBLISHED, see TransitionSchema, and
+// returns its last published version.
+func (s *Storage) PublishSchema(ctx context.Context, id string) (domain.SchemaVersion, error) {
+	fmt.Println("START Storage.PublishSchema")
+
+	schema, err := s.TransitionSchema(ctx, id, domain.StatePublished, "")
+	if err != nil {
+		return domain.SchemaVersion{}, err
+	}
+
+	fmt.Println("END Storage.PublishSchema")
+	return schema.Versions[len(schema.Versions)-1], nil
+}
+
+// TransitionSchema moves a schema to another state of its lifecycle on
+// behalf of the caller. Publishing a draft or approved schema, or a published
+// one with a changed draft, freezes its draft as the next version, unless it
+// is the same as the last one.
+func (s *Storage) TransitionSchema(ctx context.Context, id string, state domain.SchemaState, comment string) (domain.Schema, error) {
+	fmt.Println("START Storage.TransitionSchema")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return domain.Schema{}, err
+	}
+
+	// Get schema and check existance
+	previous, ok := s.schemas[id]
+	if !ok {
+		return domain.Schema{}, domain.SchemaNotFoundError(id)
+	}
+
+	now := time.Now()
+	schema, err := s.transition(ctx, previous, state, comment, now)
+	if err != nil {
+		return domain.Schema{}, err
+	}
+	s.revision++
+	schema.UpdatedAt = now
+	schema.Revision = s.revision
+	s.schemas[id] = schema
+
+	// Save database
+	err = s.SaveToFile()
+	if err != nil {
+		s.schemas[id] = previous // revert changes to avoid broken state
+		s.revision--
+		log.Printf("request %s: error saving storage to file: %v", domain.RequestInfoFrom(ctx).RequestID, err)
+		return domain.Schema{}, domain.InternalError("STORAGE_WRITE_FAILED", "internal error while changing state")
+	}
+
+	// Notify watchers
+	s.publish(domain.EventUpdated, schema)
+
+	fmt.Println("END Storage.TransitionSchema")
+	return schema, nil
+}
+
+// transition returns schema moved to state, with the change recorded.
+// Moving to IN_REVIEW opens a review, which must satisfy its policy for the
+// schema to be approved; leaving it for DRAFT withdraws the review.
+func (s *Storage) transition(ctx context.Context, schema domain.Schema, state domain.SchemaState, comment string, now time.Time) (domain.Schema, error) {
+	republish := state == domain.StatePublished && schema.State == domain.StatePublished && schema.DraftChanged()
+	if !republish {
+		if err := domain.CheckTransition(schema.State, state); err != nil {
+			return domain.Schema{}, err
+		}
+	}
+
+	actor := domain.RequestInfoFrom(ctx).CallerID
+	switch {
+	case state == domain.StateInReview:
+		review := domain.Review{
+			Number:      int64(len(schema.Reviews)) + 1,
+			RequestedBy: actor,
+			RequestedAt: now,
+			Comment:     comment,
+			Policy:      s.reviewPolicy,
+			Status:      domain.ReviewOpen,
+		}
+		schema.Reviews = append(append([]domain.Review{}, schema.Reviews...), review)
+	case state == domain.StateApproved:
+		var open int
+		schema, open = s.openReview(schema, now)
+		review := schema.Reviews[open]
+		if !review.Satisfied() {
+			return domain.Schema{}, domain.NewError(domain.ErrFailedPrecondition, "REVIEW_POLICY_NOT_SATISFIED",
+				map[string]string{"schema_id": schema.SchemaID, "approvals": fmt.Sprint(review.Approvals()), "missing_roles": strings.Join(review.MissingRoles(), ",")},
+				"review of schema with id=<%s> has %d of %d approvals, missing roles %v", schema.SchemaID, review.Approvals(), review.Policy.MinApprovals, review.MissingRoles())
+		}
+		schema.Reviews[open].Status = domain.ReviewApproved
+	case schema.State == domain.StateInReview:
+		if open := schema.OpenReview(); open >= 0 {
+			schema.Reviews = append([]domain.Review{}, schema.Reviews...)
+			schema.Reviews[open].Status = domain.ReviewWithdrawn
+		}
+	case state == domain.StatePublished && (schema.State == domain.StateDraft || republish) && s.reviewPolicy.RequiresReview():
+		return domain.Schema{}, domain.NewError(domain.ErrFailedPrecondition, "REVIEW_REQUIRED", map[string]string{"schema_id": schema.SchemaID},
+			"schema with id=<%s> must be approved before it is published", schema.SchemaID)
+	}
+
+	if state == domain.StatePublished && schema.State != domain.StateDeprecated && !schema.DraftPublished() {
+		for _, diagnostic := range domain.ValidateSchema(schema) {
+			if diagnostic.Severity == domain.SeverityError {
+				return domain.Schema{}, domain.NewError(domain.ErrFailedPrecondition, "INVALID_DRAFT",
+					map[string]string{"schema_id": schema.SchemaID, "field": diagnostic.Field},
+					"draft of schema with id=<%s> cannot be published: %s: %s", schema.SchemaID, diagnostic.Field, diagnostic.Message)
+			}
+		}
+		version := domain.SchemaVersion{
+			Version:     schema.LatestVersion() + 1,
+			SchemaName:  schema.SchemaName,
+			Tasks:       domain.CloneTasks(schema.Tasks),
+			PublishedAt: now,
+			Revision:    schema.Revision,
+		}
+		schema.Versions = append(append([]domain.SchemaVersion{}, schema.Versions...), version)
+	}
+
+	change := domain.StateChange{
+		From:    schema.State,
+		To:      state,
+		Actor:   actor,
+		Time:    now,
+		Comment: comment,
+	}
+	schema.StateHistory = append(append([]domain.StateChange{}, schema.StateHistory...), change)
+	schema.State = state
+	return schema, nil
+}
+
+// editDraft returns schema ready for its draft to be edited. A schema in
+// review goes back to DRAFT, while a published one stays published with its
+// draft changed until the changes are published.
+func (s *Storage) editDraft(ctx context.Context, schema domain.Schema, now time.Time) (domain.Schema, error) {
+	if schema.State == domain.StateDraft || schema.State == domain.StatePublished {
+		return schema, nil
+	}
+	if !schema.State.CanTransitionTo(domain.StateDraft) {
+		return domain.Schema{}, domain.NewError(domain.ErrFailedPrecondition, "SCHEMA_NOT_EDITABLE",
+			map[string]string{"schema_id": schema.SchemaID, "state": string(schema.State)},
+			"schema with id=<%s> cannot be edited in state %s", schema.SchemaID, schema.State)
+	}
+	return s.transition(ctx, schema, domain.StateDraft, "draft edited", now)
+}
+
+// openReview returns schema with its reviews copied and the index of the
+// open one. Schemas sent to review before reviews were recorded get one
+// under the current policy.
+func (s *Storage) openReview(schema domain.Schema, now time.Time) (domain.Schema, int) {
+	schema.Reviews = append([]domain.Review{}, schema.Reviews...)
+	if open := schema.OpenReview(); open >= 0 {
+		schema.Reviews[open].Records = append([]domain.ReviewRecord{}, schema.Reviews[open].Records...)
+		return schema, open
+	}
+	review := domain.Review{Number: int64(len(schema.Reviews)) + 1, RequestedAt: now, Policy: s.reviewPolicy, Status: domain.ReviewOpen}
+	schema.Reviews = append(schema.Reviews, review)
+	return schema, len(schema.Reviews) - 1
+}
+
+// ReviewSchema records the decision of the caller on the open review of a
+// schema. A rejection sends the schema back to DRAFT, and the approval
+// satisfying the policy moves it to APPROVED.
+func (s *Storage) ReviewSchema(ctx context.Context, id string, decision domain.ReviewDecision, comment string) (domain.Schema, error) {
+	fmt.Println("START Storage.ReviewSchema")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return domain.Schema{}, err
+	}
+
+	// Get schema and check existance
+	previous, ok := s.schemas[id]
+	if !ok {
+		return domain.Schema{}, domain.SchemaNotFoundError(id)
+	}
+	if previous.State != domain.StateInReview {
+		return domain.Schema{}, domain.NewError(domain.ErrFailedPrecondition, "NOT_IN_REVIEW", map[string]string{"schema_id": id, "state": string(previous.State)},
+			"schema with id=<%s> is not in review", id)
+	}
+
+	// Check the reviewer
+	now := time.Now()
+	schema, open := s.openReview(previous, now)
+	review := schema.Reviews[open]
+	info := domain.RequestInfoFrom(ctx)
+	if info.CallerID == "" {
+		return domain.Schema{}, domain.NewError(domain.ErrPermissionDenied, "ANONYMOUS_REVIEWER", map[string]string{"schema_id": id},
+			"schemas cannot be reviewed by anonymous callers")
+	}
+	if decision == domain.DecisionApprove && (info.CallerID == schema.AuthorID || info.CallerID == review.RequestedBy) {
+		return domain.Schema{}, domain.NewError(domain.ErrPermissionDenied, "SELF_APPROVAL", map[string]string{"schema_id": id, "reviewer": info.CallerID},
+			"schema with id=<%s> cannot be approved by its author or the requester of the review", id)
+	}
+	if _, ok := review.RecordOf(info.CallerID); ok {
+		return domain.Schema{}, domain.NewError(domain.ErrFailedPrecondition, "ALREADY_REVIEWED", map[string]string{"schema_id": id, "reviewer": info.CallerID},
+			"review %d of schema with id=<%s> already has a decision of %s", review.Number, id, info.CallerID)
+	}
+
+	// Record the decision, and close the review once decided
+	record := domain.ReviewRecord{
+		Reviewer: info.CallerID,
+		Roles:    info.CallerRoles,
+		Decision: decision,
+		Comment:  comment,
+		Time:     now,
+	}
+	schema.Reviews[open].Records = append(schema.Reviews[open].Records, record)
+	var err error
+	switch {
+	case decision == domain.DecisionReject:
+		schema.Reviews[open].Status = domain.ReviewRejected
+		schema, err = s.transition(ctx, schema, domain.StateDraft, comment, now)
+	case schema.Reviews[open].Satisfied():
+		schema, err = s.transition(ctx, schema, domain.StateApproved, comment, now)
+	}
+	if err != nil {
+		return domain.Schema{}, err
+	}
+	s.revision++
+	schema.UpdatedAt = now
+	schema.Revision = s.revision
+	s.schemas[id] = schema
+
+	// Save database
+	err = s.SaveToFile()
+	if err != nil {
+		s.schemas[id] = previous // revert changes to avoid broken state
+		s.revision--
+		log.Printf("request %s: error saving storage to file: %v", info.RequestID, err)
+		return domain.Schema{}, domain.InternalError("STORAGE_WRITE_FAILED", "internal error while reviewing schema")
+	}
+
+	// Notify watchers
+	s.publish(domain.EventUpdated, schema)
+
+	fmt.Println("END Storage.ReviewSchema")
+	return schema, nil
+}
+
+func (s *Storage) GetSchemaVersions(ctx context.Context, id string) ([]domain.SchemaVersion, error) {
+	fmt.Println("START Storage.GetSchemaVersions")
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	versions, ok := s.versionsOf(id)
+	if !ok {
+		return nil, domain.SchemaNotFoundError(id)
+	}
+
+	fmt.Println("END Storage.GetSchemaVersions")
+	return append([]domain.SchemaVersion{}, versions...), nil
+}
+
+func (s *Storage) GetSchemaVersion(ctx context.Context, id string, version int64) (domain.SchemaVersion, error) {
+	fmt.Println("START Storage.GetSchemaVersion")
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if err := ctx.Err(); err != nil {
+		return domain.SchemaVersion{}, err
+	}
+
+	versions, ok := s.versionsOf(id)
+	if !ok {
+		return domain.SchemaVersion{}, domain.SchemaNotFoundError(id)
+	}
+	if version < 1 || version > int64(len(versions)) {
+		return domain.SchemaVersion{}, domain.SchemaVersionNotFoundError(id, version)
+	}
+
+	fmt.Println("END Storage.GetSchemaVersion")
+	return versions[version-1], nil
+}
+
+// versionsOf returns the published versions of a live or deleted schema.
+func (s *Storage) versionsOf(id string) ([]domain.SchemaVersion, bool) {
+	if schema, ok := s.schemas[id]; ok {
+		return schema.Versions, true
+	}
+	tombstone, ok := s.tombstones[id]
+	return tombstone.Versions, ok && len(tombstone.Versions) > 0
+}
+
+func (s *Storage) DeleteSchemaByID(ctx context.Context, id string) error {
 	fmt.Println("START Storage.DeleteSchemaByID")
 
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	// Get schema and check existance
 	schema, ok := s.schemas[id]
 	if !ok {
-		return fmt.Errorf("schema with id=<%s> not found", id)
+		return domain.SchemaNotFoundError(id)
+	}
+	if err := schema.CheckDeletable(); err != nil {
+		return err
 	}
 
-	// Delete schema from storage
-	delete(s.schemas, id)
+	// Delete schema from storage, leaving a tombstone
+	deleted := s.removeSchema(schema)
 
 	// Save database
 	err := s.SaveToFile()
 	if err != nil {
-		s.schemas[id] = schema // revert changes to avoid broken state
-		log.Fatalf("error saving storage to file: %v", err)
-		return fmt.Errorf("internal error while deletion")
+		s.restoreSchema(schema) // revert changes to avoid broken state
+		s.revision--
+		log.Printf("request %s: error saving storage to file: %v", domain.RequestInfoFrom(ctx).RequestID, err)
+		return domain.InternalError("STORAGE_WRITE_FAILED", "internal error while deletion")
 	}
 
+	// Notify watchers
+	s.publish(domain.EventDeleted, deleted)
+
 	fmt.Println("END Storage.DeleteSchemaByID")
 	return nil
 }
+
+// ImportSchemas stores a batch of imported schemas with a single save,
+// resolving name conflicts with policy, including conflicts between schemas
+// of the batch. The batch is all or nothing: under ConflictFail a conflict
+// leaves the store unchanged and fails with ErrAlreadyExists, the results
+// telling which schemas conflicted.
+func (s *Storage) ImportSchemas(ctx context.Context, items []domain.ImportItem, policy domain.ConflictPolicy) ([]domain.ImportResult, error) {
+	fmt.Println("START Storage.ImportSchemas")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]string)
+	for id, schema := range s.schemas {
+		byName[schema.SchemaName] = id
+	}
+
+	// Look for conflicts first when they abort the batch
+	results := make([]domain.ImportResult, len(items))
+	if policy == domain.ConflictFail {
+		var conflict error
+		seen := make(map[string]bool)
+		for i, item := range items {
+			name := item.Schema.SchemaName
+			results[i] = domain.ImportResult{Index: item.Index, Outcome: domain.ImportFailed, SchemaName: name}
+			if _, ok := byName[name]; ok || seen[name] {
+				results[i].Err = domain.SchemaNameTakenError(name)
+				if conflict == nil {
+					conflict = results[i].Err
+				}
+			}
+			seen[name] = true
+		}
+		if conflict != nil {
+			for i := range results {
+				if results[i].Err == nil {
+					results[i].Err = domain.NewError(domain.ErrAborted, "IMPORT_ABORTED", nil, "not imported: %v", conflict)
+				}
+			}
+			return results, conflict
+		}
+	}
+
+	// Apply the batch, remembering how to undo it
+	type change struct {
+		eventType domain.EventType
+		schema    domain.Schema
+		previous  *domain.Schema // overwritten schema, nil for created ones
+	}
+	var changes []change
+	previousRevision := s.revision
+	now := time.Now()
+	for i, item := range items {
+		name := item.Schema.SchemaName
+		existingID, conflict := byName[name]
+
+		if conflict && policy == domain.ConflictSkip {
+			results[i] = domain.ImportResult{Index: item.Index, Outcome: domain.ImportSkipped, SchemaID: existingID, SchemaName: name}
+			continue
+		}
+
+		if conflict && policy == domain.ConflictOverwrite {
+			previous := s.schemas[existingID]
+			schema, err := s.editDraft(ctx, previous, now)
+			if err != nil {
+				results[i] = domain.ImportResult{Index: item.Index, Outcome: domain.ImportFailed, SchemaID: existingID, SchemaName: name, Err: err}
+				continue
+			}
+			s.revision++
+			schema.AuthorID = item.Schema.AuthorID
+			schema.Tasks = domain.CloneTasks(item.Schema.Tasks)
+			domain.KeepV2Fields(schema.Tasks, previous.Tasks) // imports come from version 1
+			schema.UpdatedAt = now
+			schema.Revision = s.revision
+			s.schemas[existingID] = schema
+			s.index.Add(schema)
+			changes = append(changes, change{domain.EventUpdated, schema, &previous})
+			results[i] = domain.ImportResult{Index: item.Index, Outcome: domain.ImportOverwritten, SchemaID: existingID, SchemaName: name}
+			continue
+		}
+
+		s.revision++
+		outcome := domain.ImportCreated
+		if conflict { // ConflictRename
+			name = freeName(name, byName)
+			outcome = domain.ImportRenamed
+		}
+		schema := domain.Schema{
+			SchemaID:   s.newSchemaID(),
+			AuthorID:   item.Schema.AuthorID,
+			SchemaName: name,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+			Tasks:      item.Schema.Tasks,
+			Revision:   s.revision,
+			State:      domain.StateDraft,
+		}
+		schema.Slug = s.newSlug(name, schema.SchemaID)
+		s.schemas[schema.SchemaID] = schema
+		s.index.Add(schema)
+		s.indexSlugs(schema)
+		byName[name] = schema.SchemaID
+		changes = append(changes, change{domain.EventCreated, schema, nil})
+		results[i] = domain.ImportResult{Index: item.Index, Outcome: outcome, SchemaID: schema.SchemaID, SchemaName: name}
+	}
+
+	// Save database
+	err := s.SaveToFile()
+	if err != nil {
+		for i := len(changes) - 1; i >= 0; i-- { // revert changes to avoid broken state
+			id := changes[i].schema.SchemaID
+			if previous := changes[i].previous; previous != nil {
+				s.schemas[id] = *previous
+				s.index.Add(*previous)
+			} else {
+				delete(s.schemas, id)
+				s.index.Remove(id)
+				s.unindexSlugs(changes[i].schema)
+			}
+		}
+		s.revision = previousRevision
+		log.Printf("request %s: error saving storage to file: %v", domain.RequestInfoFrom(ctx).RequestID, err)
+		return nil, domain.InternalError("STORAGE_WRITE_FAILED", "internal error while import")
+	}
+
+	// Notify watchers
+	for _, c := range changes {
+		s.publish(c.eventType, c.schema)
+	}
+
+	fmt.Println("END Storage.ImportSchemas")
+	return results, nil
+}
+
+// freeName returns name suffixed with the first free " (n)", n >= 2.
+func freeName(name string, taken map[string]string) string {
+	for n := 2; ; n++ {
+		candidate := fmt.Sprintf("%s (%d)", name, n)
+		if _, ok := taken[candidate]; !ok {
+			return candidate
+		}
+	}
+}
+
+func (s *Storage) GetDerivedSchemas(ctx context.Context, id string, transitive bool) ([]domain.Schema, error) {
+	fmt.Println("START Storage.GetDerivedSchemas")
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Derivatives outlive their parent, so deleted schemas can still be queried
+	_, live := s.schemas[id]
+	_, deleted := s.tombstones[id]
+	if !live && !deleted {
+		return nil, domain.SchemaNotFoundError(id)
+	}
+
+	// Group schemas by parent once, then walk the lineage breadth first,
+	// through deleted schemas without returning them
+	children := make(map[string][]string)
+	for _, schema := range s.schemas {
+		if schema.ParentID != "" {
+			children[schema.ParentID] = append(children[schema.ParentID], schema.SchemaID)
+		}
+	}
+	for _, tombstone := range s.tombstones {
+		if tombstone.ParentID != "" {
+			children[tombstone.ParentID] = append(children[tombstone.ParentID], tombstone.SchemaID)
+		}
+	}
+
+	derived := []domain.Schema{}
+	parents := []string{id}
+	for len(parents) > 0 {
+		var next []string
+		for _, parent := range parents {
+			for _, child := range children[parent] {
+				if schema, ok := s.schemas[child]; ok {
+					derived = append(derived, schema)
+				}
+				next = append(next, child)
+			}
+		}
+		if !transitive {
+			break
+		}
+		parents = next
+	}
+
+	sortByCreation(derived)
+
+	fmt.Println("END Storage.GetDerivedSchemas")
+	return derived, nil
+}
+
+func (s *Storage) GetSchemasByIDs(ctx context.Context, ids []string) ([]domain.SchemaResult, error) {
+	fmt.Println("START Storage.GetSchemasByIDs")
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Resolve every id, reporting missing ones individually
+	results := make([]domain.SchemaResult, 0, len(ids))
+	for _, id := range ids {
+		schema, ok := s.schemas[id]
+		if !ok {
+			results = append(results, domain.SchemaResult{SchemaID: id, Err: domain.SchemaNotFoundError(id)})
+			continue
+		}
+		results = append(results, domain.SchemaResult{SchemaID: id, Schema: schema})
+	}
+
+	fmt.Println("END Storage.GetSchemasByIDs")
+	return results, nil
+}
+
+func (s *Storage) DeleteSchemasByIDs(ctx context.Context, ids []string) ([]domain.SchemaResult, error) {
+	fmt.Println("START Storage.DeleteSchemasByIDs")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Delete every present schema, reporting missing ones individually
+	results := make([]domain.SchemaResult, 0, len(ids))
+	deleted := make(map[string]domain.Schema)
+	previousRevision := s.revision
+	for _, id := range ids {
+		schema, ok := s.schemas[id]
+		if !ok {
+			results = append(results, domain.SchemaResult{SchemaID: id, Err: domain.SchemaNotFoundError(id)})
+			continue
+		}
+		if err := schema.CheckDeletable(); err != nil {
+			results = append(results, domain.SchemaResult{SchemaID: id, Err: err})
+			continue
+		}
+		deleted[id] = schema
+		results = append(results, domain.SchemaResult{SchemaID: id, Schema: s.removeSchema(schema)})
+	}
+
+	if len(deleted) == 0 {
+		fmt.Println("END Storage.DeleteSchemasByIDs")
+		return results, nil
+	}
+
+	// Save database once for the whole batch
+	err := s.SaveToFile()
+	if err != nil {
+		for _, schema := range deleted { // revert changes to avoid broken state
+			s.restoreSchema(schema)
+		}
+		s.revision = previousRevision
+		log.Printf("request %s: error saving storage to file: %v", domain.RequestInfoFrom(ctx).RequestID, err)
+		return nil, domain.InternalError("STORAGE_WRITE_FAILED", "internal error while deletion")
+	}
+
+	// Notify watchers in request order
+	for _, result := range results {
+		if result.Err == nil {
+			s.publish(domain.EventDeleted, result.Schema)
+		}
+	}
+
+	fmt.Println("END Storage.DeleteSchemasByIDs")
+	return results, nil
+}
+
+func (s *Storage) SearchSchemas(ctx context.Context, query string, limit int) ([]domain.SearchResult, error) {
+	fmt.Println("START Storage.SearchSchemas")
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Resolve index hits to the stored schemas
+	var results []domain.SearchResult
+	for _, hit := range s.index.Search(query, limit) {
+		results = append(results, domain.SearchResult{
+			Schema:  s.schemas[hit.SchemaID],
+			Score:   hit.Score,
+			Matches: hit.Matches,
+		})
+	}
+
+	fmt.Println("END Storage.SearchSchemas")
+	return results, nil
+}
+
+func (s *Storage) GetChangesSince(ctx context.Context, revision int64, limit int) (domain.ChangeSet, error) {
+	fmt.Println("START Storage.GetChangesSince")
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if err := ctx.Err(); err != nil {
+		return domain.ChangeSet{}, err
+	}
+
+	if revision > s.revision {
+		return domain.ChangeSet{}, domain.NewError(domain.ErrFailedPrecondition, "REVISION_IN_FUTURE",
+			map[string]string{"current_revision": fmt.Sprint(s.revision)},
+			"revision %d is ahead of the current revision %d", revision, s.revision)
+	}
+	if limit <= 0 {
+		limit = defaultChangesLimit
+	}
+
+	// Collect changes after the revision, oldest first
+	type change struct {
+		revision  int64
+		schema    *domain.Schema
+		tombstone *domain.Tombstone
+	}
+	var changes []change
+	for _, schema := range s.schemas {
+		if schema.Revision > revision {
+			schema := schema
+			changes = append(changes, change{revision: schema.Revision, schema: &schema})
+		}
+	}
+	for _, tombstone := range s.tombstones {
+		if tombstone.Revision > revision {
+			tombstone := tombstone
+			changes = append(changes, change{revision: tombstone.Revision, tombstone: &tombstone})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].revision < changes[j].revision
+	})
+
+	// Page through them, the new high-water mark being the last change sent
+	changeSet := domain.ChangeSet{Revision: s.revision}
+	if len(changes) > limit {
+		changes = changes[:limit]
+		changeSet.Revision = changes[limit-1].revision
+		changeSet.HasMore = true
+	}
+	for _, c := range changes {
+		if c.schema != nil {
+			changeSet.Schemas = append(changeSet.Schemas, *c.schema)
+		} else {
+			changeSet.Tombstones = append(changeSet.Tombstones, *c.tombstone)
+		}
+	}
+
+	fmt.Println("END Storage.GetChangesSince")
+	return changeSet, nil
+}
+
+func (s *Storage) WatchSchemas(ctx context.Context, fromRevision int64, filter domain.EventFilter) (domain.EventStream, error) {
+	fmt.Println("START Storage.WatchSchemas")
+
+	sub, err := s.events.Subscribe(fromRevision, filter)
+	if err != nil {
+		return nil, err
+	}
+	context.AfterFunc(ctx, sub.Close) // end the feed with the call
+
+	fmt.Println("END Storage.WatchSchemas")
+	return sub, nil
+}
+
+// newSchemaID generates an id used by no schema, live or deleted.
+func (s *Storage) newSchemaID() string {
+	id := uuid.New().String()
+	for { // to avoid (really improbable) collisions
+		_, live := s.schemas[id]
+		_, deleted := s.tombstones[id]
+		if !live && !deleted {
+			return id
+		}
+		id = uuid.New().String()
+	}
+}
+
+// removeSchema replaces a live schema with a tombstone at the next revision
+// and returns the schema as deleted.
+func (s *Storage) removeSchema(schema domain.Schema) domain.Schema {
+	s.revision++
+	deleted := schema
+	deleted.DeletedAt = time.Now()
+	deleted.Revision = s.revision
+
+	delete(s.schemas, schema.SchemaID)
+	s.index.Remove(schema.SchemaID)
+	s.unindexSlugs(schema)
+	s.tombstones[schema.SchemaID] = domain.Tombstone{
+		SchemaID:  schema.SchemaID,
+		Revision:  deleted.Revision,
+		DeletedAt: deleted.DeletedAt,
+		ParentID:  schema.ParentID,
+		Versions:  schema.Versions,
+	}
+	return deleted
+}
+
+// restoreSchema undoes removeSchema, except for the revision counter.
+func (s *Storage) restoreSchema(schema domain.Schema) {
+	delete(s.tombstones, schema.SchemaID)
+	s.schemas[schema.SchemaID] = schema
+	s.index.Add(schema)
+	s.indexSlugs(schema)
+}
+
+// newSlug returns a slug for name that no other live schema uses, as its
+// current or former slug.
+func (s *Storage) newSlug(name string, id string) string {
+	return domain.FreeSlug(domain.Slugify(name), func(slug string) bool {
+		owner, ok := s.slugs[slug]
+		return ok && owner != id
+	})
+}
+
+func (s *Storage) indexSlugs(schema domain.Schema) {
+	s.slugs[schema.Slug] = schema.SchemaID
+	for _, alias := range schema.SlugAliases {
+		s.slugs[alias] = schema.SchemaID
+	}
+}
+
+func (s *Storage) unindexSlugs(schema domain.Schema) {
+	for _, slug := range append([]string{schema.Slug}, schema.SlugAliases...) {
+		if s.slugs[slug] == schema.SchemaID {
+			delete(s.slugs, slug)
+		}
+	}
+}
+
+// sortByCreation orders schemas by creation time, then id.
+func sortByCreation(schemas []domain.Schema) {
+	sort.Slice(schemas, func(i, j int) bool {
+		if !schemas[i].CreatedAt.Equal(schemas[j].CreatedAt) {
+			return schemas[i].CreatedAt.Before(schemas[j].CreatedAt)
+		}
+		return schemas[i].SchemaID < schemas[j].SchemaID
+	})
+}
+
+// publish emits a change event for a schema at its current revision; callers
+// hold the write lock so that events are published in revision order.
+func (s *Storage) publish(eventType domain.EventType, schema domain.Schema) {
+	s.events.Publish(domain.SchemaEvent{
+		Revision:  schema.Revision,
+		Type:      eventType,
+		SchemaID:  schema.SchemaID,
+		Schema:    schema,
+		Timestamp: time.Now(),
+	})
+}
@@ -1,12 +1,19 @@
 package storage_test
 
 import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
 	"reflect"
 	"server/internal/domain"
 	"server/internal/providers/storage"
+	"strings"
 	"testing"
 )
 
+var ctx context.Context = context.Background()
+
 func TestCreateSchema(t *testing.T) {
 	storageService, err := storage.NewStorage("./test_storage.json", true)
 	if err != nil {
@@ -14,22 +21,22 @@ func TestCreateSchema(t *testing.T) {
 	}
 
 	t.Run("Cannot create schema with used name", func(t *testing.T) {
-		_, err := storageService.CreateSchema("authorID", "Schema2", []domain.Task{})
+		_, err := storageService.CreateSchema(ctx, "authorID", "Schema2", []domain.Task{})
 
-		if err == nil {
-			t.Errorf("Expected error, got nil")
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("Expected ErrAlreadyExists, got %v", err)
 		}
 	})
 
 	t.Run("Creates schema with valid name", func(t *testing.T) {
 		// Create schema
-		createdSchema, err := storageService.CreateSchema("authorID1", "schemaName1", []domain.Task{})
+		createdSchema, err := storageService.CreateSchema(ctx, "authorID1", "schemaName1", []domain.Task{})
 		if err != nil {
 			t.Errorf("Expected no error, got %v", err)
 		}
 
 		// Schema exists after creation
-		foundSchema, err := storageService.GetSchemaByID(createdSchema.SchemaID)
+		foundSchema, err := storageService.GetSchemaByID(ctx, createdSchema.SchemaID)
 		if err != nil {
 			t.Errorf("Expected no error, got %v", err)
 		}
@@ -49,7 +56,7 @@ func TestGetAllSchemas(t *testing.T) {
 
 	t.Run("All schemas", func(t *testing.T) {
 		expectedSchemasLen := 3
-		foundSchemas, err := storageService.GetAllSchemas()
+		foundSchemas, err := storageService.GetAllSchemas(ctx)
 
 		if err != nil {
 			t.Errorf("Expected no error, got %v", err)
@@ -68,10 +75,10 @@ func TestGetSchemaByID(t *testing.T) {
 	}
 
 	t.Run("Schema not present", func(t *testing.T) {
-		_, err := storageService.GetSchemaByID("SchemaNotPresent")
+		_, err := storageService.GetSchemaByID(ctx, "SchemaNotPresent")
 
-		if err == nil {
-			t.Errorf("Expected error, got nil")
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
 		}
 	})
 
@@ -79,7 +86,7 @@ func TestGetSchemaByID(t *testing.T) {
 		schemaId := "dd19b4f7-a4be-4ec8-a48b-be6c4f769b4b"
 		expectedSchemaAuthorId := "Author1"
 		expectedSchemaName := "Schema2"
-		foundSchema, err := storageService.GetSchemaByID(schemaId)
+		foundSchema, err := storageService.GetSchemaByID(ctx, schemaId)
 
 		if err != nil {
 			t.Errorf("Expected no error, got %v", err)
@@ -106,25 +113,1174 @@ func TestDeleteSchemaByID(t *testing.T) {
 	}
 
 	t.Run("Schema not present", func(t *testing.T) {
-		err := storageService.DeleteSchemaByID("SchemaNotPresent")
+		err := storageService.DeleteSchemaByID(ctx, "SchemaNotPresent")
 
-		if err == nil {
-			t.Errorf("Expected error, got nil")
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
 		}
 	})
 
 	t.Run("Schema present", func(t *testing.T) {
 		schemaId := "dd19b4f7-a4be-4ec8-a48b-be6c4f769b4b"
-		err := storageService.DeleteSchemaByID(schemaId)
+		err := storageService.DeleteSchemaByID(ctx, schemaId)
 
 		if err != nil {
 			t.Errorf("Expected no error, got %v", err)
 		}
 
 		// Schema should not be in the storage anymore
-		_, err = storageService.GetSchemaByID(schemaId)
+		_, err = storageService.GetSchemaByID(ctx, schemaId)
 		if err == nil {
 			t.Errorf("Expected error, got nil")
 		}
 	})
 }
+
+func TestGetSchemasByIDs(t *testing.T) {
+	storageService, err := storage.NewStorage("./test_storage.json", true)
+	if err != nil {
+		t.Errorf("Failed to create storage: %v", err)
+	}
+
+	t.Run("Reports missing ids individually", func(t *testing.T) {
+		presentId := "dd19b4f7-a4be-4ec8-a48b-be6c4f769b4b"
+		results, err := storageService.GetSchemasByIDs(ctx, []string{"SchemaNotPresent", presentId})
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if len(results) != 2 {
+			t.Fatalf("Expected 2 results, got %d", len(results))
+		}
+
+		if !errors.Is(results[0].Err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", results[0].Err)
+		}
+
+		if results[1].Err != nil || results[1].Schema.SchemaID != presentId {
+			t.Errorf("Expected schema %s, got %+v", presentId, results[1])
+		}
+	})
+}
+
+func TestDeleteSchemasByIDs(t *testing.T) {
+	storageService, err := storage.NewStorage("./test_storage.json", true)
+	if err != nil {
+		t.Errorf("Failed to create storage: %v", err)
+	}
+
+	t.Run("Deletes present schemas", func(t *testing.T) {
+		ids := []string{"0abd659f-8e41-4e72-9c6e-170be7745b00", "SchemaNotPresent", "dd19b4f7-a4be-4ec8-a48b-be6c4f769b4b"}
+		results, err := storageService.DeleteSchemasByIDs(ctx, ids)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if len(results) != 3 || results[0].Err != nil || !errors.Is(results[1].Err, domain.ErrNotFound) || results[2].Err != nil {
+			t.Errorf("Unexpected results %+v", results)
+		}
+
+		// Only one schema should be left
+		foundSchemas, _ := storageService.GetAllSchemas(ctx)
+		if len(foundSchemas) != 1 {
+			t.Errorf("Expected 1 schema left, found: %d", len(foundSchemas))
+		}
+	})
+}
+
+func TestSearchSchemas(t *testing.T) {
+	storageService, err := storage.NewStorage("./test_storage.json", true)
+	if err != nil {
+		t.Errorf("Failed to create storage: %v", err)
+	}
+
+	t.Run("Finds schema by nested task comment", func(t *testing.T) {
+		results, err := storageService.SearchSchemas(ctx, "comment task 2", 10)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if len(results) == 0 {
+			t.Fatalf("Expected results, got none")
+		}
+
+		if results[0].Schema.SchemaID == "" {
+			t.Errorf("Expected result to carry the stored schema")
+		}
+	})
+
+	t.Run("Index follows mutations", func(t *testing.T) {
+		createdSchema, err := storageService.CreateSchema(ctx, "authorID", "Évaluation gériatrique", []domain.Task{})
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		results, _ := storageService.SearchSchemas(ctx, "evaluation", 10)
+		if len(results) != 1 || results[0].Schema.SchemaID != createdSchema.SchemaID {
+			t.Errorf("Expected created schema to be found, got %+v", results)
+		}
+
+		storageService.DeleteSchemaByID(ctx, createdSchema.SchemaID)
+
+		results, _ = storageService.SearchSchemas(ctx, "evaluation", 10)
+		if len(results) != 0 {
+			t.Errorf("Expected deleted schema not to be found, got %+v", results)
+		}
+	})
+}
+
+func TestWatchSchemas(t *testing.T) {
+	storageService, err := storage.NewStorage("./test_storage.json", true)
+	if err != nil {
+		t.Errorf("Failed to create storage: %v", err)
+	}
+
+	t.Run("Emits events for mutations", func(t *testing.T) {
+		stream, err := storageService.WatchSchemas(ctx, 0, domain.EventFilter{AuthorID: "watcher"})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		defer stream.Close()
+
+		storageService.CreateSchema(ctx, "someoneElse", "ignoredSchema", []domain.Task{})
+		createdSchema, _ := storageService.CreateSchema(ctx, "watcher", "watchedSchema", []domain.Task{})
+		storageService.DeleteSchemaByID(ctx, createdSchema.SchemaID)
+
+		created := <-stream.Events()
+		deleted := <-stream.Events()
+
+		if created.Type != domain.EventCreated || created.SchemaID != createdSchema.SchemaID {
+			t.Errorf("Expected CREATED event for %s, got %+v", createdSchema.SchemaID, created)
+		}
+
+		if deleted.Type != domain.EventDeleted || deleted.Revision != created.Revision+1 {
+			t.Errorf("Expected DELETED event after %d, got %+v", created.Revision, deleted)
+		}
+	})
+
+	t.Run("Resumes from a revision", func(t *testing.T) {
+		// The three stored schemas are migrated to revisions 1-3, so the
+		// mutations above were published as revisions 4-6
+		stream, err := storageService.WatchSchemas(ctx, 4, domain.EventFilter{})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		defer stream.Close()
+
+		replayed := <-stream.Events()
+		if replayed.Revision != 5 {
+			t.Errorf("Expected replay to start at revision 5, got %d", replayed.Revision)
+		}
+	})
+
+	t.Run("Compacted revision", func(t *testing.T) {
+		_, err := storageService.WatchSchemas(ctx, 1, domain.EventFilter{})
+
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected ErrFailedPrecondition, got %v", err)
+		}
+	})
+}
+
+func TestGetChangesSince(t *testing.T) {
+	storageService, err := storage.NewStorage("./test_storage.json", true)
+	if err != nil {
+		t.Errorf("Failed to create storage: %v", err)
+	}
+
+	t.Run("Full sync", func(t *testing.T) {
+		changes, err := storageService.GetChangesSince(ctx, 0, 0)
+
+		if err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+
+		if len(changes.Schemas) != 3 || changes.Revision != 3 || changes.HasMore {
+			t.Errorf("Expected 3 schemas up to revision 3, got %+v", changes)
+		}
+
+		for i, schema := range changes.Schemas {
+			if schema.Revision != int64(i+1) {
+				t.Errorf("Expected schemas ordered by revision, got %d at %d", schema.Revision, i)
+			}
+		}
+	})
+
+	t.Run("Revision in future", func(t *testing.T) {
+		_, err := storageService.GetChangesSince(ctx, 100, 0)
+
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected ErrFailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("Incremental sync", func(t *testing.T) {
+		createdSchema, _ := storageService.CreateSchema(ctx, "authorID", "syncedSchema", []domain.Task{})
+		deletedID := "dd19b4f7-a4be-4ec8-a48b-be6c4f769b4b"
+		storageService.DeleteSchemaByID(ctx, deletedID)
+
+		changes, err := storageService.GetChangesSince(ctx, 3, 0)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(changes.Schemas) != 1 || changes.Schemas[0].SchemaID != createdSchema.SchemaID || changes.Schemas[0].Revision != 4 {
+			t.Errorf("Expected created schema at revision 4, got %+v", changes.Schemas)
+		}
+
+		if len(changes.Tombstones) != 1 || changes.Tombstones[0].SchemaID != deletedID || changes.Tombstones[0].Revision != 5 {
+			t.Errorf("Expected tombstone at revision 5, got %+v", changes.Tombstones)
+		}
+
+		if changes.Revision != 5 || changes.HasMore {
+			t.Errorf("Expected high-water mark 5 without more changes, got %+v", changes)
+		}
+	})
+
+	t.Run("Paginates", func(t *testing.T) {
+		// Revision 2 was superseded by its tombstone, leaving revisions 1, 3, 4, 5
+		changes, err := storageService.GetChangesSince(ctx, 0, 2)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(changes.Schemas) != 2 || changes.Revision != 3 || !changes.HasMore {
+			t.Errorf("Expected first page up to revision 3, got %+v", changes)
+		}
+
+		changes, _ = storageService.GetChangesSince(ctx, changes.Revision, 2)
+		if len(changes.Schemas) != 1 || len(changes.Tombstones) != 1 || changes.Revision != 5 || changes.HasMore {
+			t.Errorf("Expected last page up to revision 5, got %+v", changes)
+		}
+	})
+}
+
+func TestCloneSchema(t *testing.T) {
+	storageService, err := storage.NewStorage("./test_storage.json", true)
+	if err != nil {
+		t.Errorf("Failed to create storage: %v", err)
+	}
+
+	tasks := []domain.Task{
+		{ID: 10, Name: "Task 10", Children: []domain.Task{
+			{ID: 20, Name: "Task 20", BlockedBy: []int64{30}},
+		}},
+		{ID: 30, Name: "Task 30", BlockedBy: []int64{10, 99}},
+	}
+	source, err := storageService.CreateSchema(ctx, "authorID", "sourceSchema", tasks)
+	if err != nil {
+		t.Fatalf("Failed to create source schema: %v", err)
+	}
+
+	t.Run("Source not present", func(t *testing.T) {
+		_, err := storageService.CloneSchema(ctx, "SchemaNotPresent", "authorID", "copy", false)
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("Name already used", func(t *testing.T) {
+		_, err := storageService.CloneSchema(ctx, source.SchemaID, "authorID", "Schema1", false)
+
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("Expected ErrAlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("Deep copy", func(t *testing.T) {
+		cloned, err := storageService.CloneSchema(ctx, source.SchemaID, "otherAuthor", "deepCopy", false)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if cloned.SchemaID == source.SchemaID || cloned.AuthorID != "otherAuthor" || cloned.ParentID != source.SchemaID {
+			t.Errorf("Unexpected clone %+v", cloned)
+		}
+
+		if !reflect.DeepEqual(cloned.Tasks, source.Tasks) {
+			t.Errorf("Expected the tasks to be copied, got %+v", cloned.Tasks)
+		}
+
+		// Changing the copy must leave the source untouched
+		cloned.Tasks[0].Children[0].BlockedBy[0] = 42
+		stored, _ := storageService.GetSchemaByID(ctx, source.SchemaID)
+		if stored.Tasks[0].Children[0].BlockedBy[0] != 30 {
+			t.Errorf("Expected source tasks to be unchanged, got %+v", stored.Tasks)
+		}
+	})
+
+	t.Run("Renumber task ids", func(t *testing.T) {
+		cloned, err := storageService.CloneSchema(ctx, source.SchemaID, "otherAuthor", "renumberedCopy", true)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		expected := []domain.Task{
+			{ID: 1, Name: "Task 10", Children: []domain.Task{
+				{ID: 2, Name: "Task 20", BlockedBy: []int64{3}},
+			}},
+			{ID: 3, Name: "Task 30", BlockedBy: []int64{1}},
+		}
+		if !reflect.DeepEqual(cloned.Tasks, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, cloned.Tasks)
+		}
+	})
+}
+
+func TestGetDerivedSchemas(t *testing.T) {
+	storageService, err := storage.NewStorage("./test_storage.json", true)
+	if err != nil {
+		t.Errorf("Failed to create storage: %v", err)
+	}
+
+	rootID := "0abd659f-8e41-4e72-9c6e-170be7745b00"
+	child, _ := storageService.CloneSchema(ctx, rootID, "authorID", "child", false)
+	grandchild, _ := storageService.CloneSchema(ctx, child.SchemaID, "authorID", "grandchild", false)
+
+	t.Run("Schema not present", func(t *testing.T) {
+		_, err := storageService.GetDerivedSchemas(ctx, "SchemaNotPresent", false)
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("Direct derivatives", func(t *testing.T) {
+		derived, err := storageService.GetDerivedSchemas(ctx, rootID, false)
+
+		if err != nil || len(derived) != 1 || derived[0].SchemaID != child.SchemaID {
+			t.Errorf("Expected only %s, got %+v (%v)", child.SchemaID, derived, err)
+		}
+	})
+
+	t.Run("Transitive derivatives", func(t *testing.T) {
+		derived, err := storageService.GetDerivedSchemas(ctx, rootID, true)
+
+		if err != nil || len(derived) != 2 || derived[1].SchemaID != grandchild.SchemaID {
+			t.Errorf("Expected %s and %s, got %+v (%v)", child.SchemaID, grandchild.SchemaID, derived, err)
+		}
+	})
+
+	t.Run("Deleted parent", func(t *testing.T) {
+		storageService.DeleteSchemaByID(ctx, rootID)
+		derived, err := storageService.GetDerivedSchemas(ctx, rootID, false)
+
+		if err != nil || len(derived) != 1 {
+			t.Errorf("Expected the derivative to outlive its parent, got %+v (%v)", derived, err)
+		}
+	})
+
+	t.Run("Deleted intermediate", func(t *testing.T) {
+		path := t.TempDir() + "/storage.json"
+		saving, err := storage.NewStorage(path, false)
+		if err != nil {
+			t.Fatalf("Failed to create storage: %v", err)
+		}
+		a, _ := saving.CreateSchema(ctx, "authorID", "A", nil)
+		b, _ := saving.CloneSchema(ctx, a.SchemaID, "authorID", "B", false)
+		c, _ := saving.CloneSchema(ctx, b.SchemaID, "authorID", "C", false)
+		if err := saving.DeleteSchemaByID(ctx, b.SchemaID); err != nil {
+			t.Fatalf("Failed to delete B: %v", err)
+		}
+
+		reloaded, err := storage.NewStorage(path, false)
+		if err != nil {
+			t.Fatalf("Failed to reload storage: %v", err)
+		}
+		for _, s := range []*storage.Storage{saving, reloaded} {
+			derived, err := s.GetDerivedSchemas(ctx, a.SchemaID, true)
+			if err != nil || len(derived) != 1 || derived[0].SchemaID != c.SchemaID {
+				t.Errorf("Expected only %s, got %+v (%v)", c.SchemaID, derived, err)
+			}
+			if direct, err := s.GetDerivedSchemas(ctx, a.SchemaID, false); err != nil || len(direct) != 0 {
+				t.Errorf("Expected no live direct derivative, got %+v (%v)", direct, err)
+			}
+		}
+	})
+}
+
+func TestImportSchemas(t *testing.T) {
+	storageService, err := storage.NewStorage("./test_storage.json", true)
+	if err != nil {
+		t.Errorf("Failed to create storage: %v", err)
+	}
+
+	items := func(names ...string) []domain.ImportItem {
+		var items []domain.ImportItem
+		for i, name := range names {
+			items = append(items, domain.ImportItem{Index: i, Schema: domain.Schema{AuthorID: "importer", SchemaName: name, Tasks: []domain.Task{{ID: 1}}}})
+		}
+		return items
+	}
+	outcomes := func(results []domain.ImportResult) []domain.ImportOutcome {
+		var outcomes []domain.ImportOutcome
+		for _, result := range results {
+			outcomes = append(outcomes, result.Outcome)
+		}
+		return outcomes
+	}
+
+	t.Run("Fail aborts the batch", func(t *testing.T) {
+		before, _ := storageService.GetAllSchemas(ctx)
+		results, err := storageService.ImportSchemas(ctx, items("fresh", "Schema1"), domain.ConflictFail)
+
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("Expected ErrAlreadyExists, got %v", err)
+		}
+		if !errors.Is(results[0].Err, domain.ErrAborted) || !errors.Is(results[1].Err, domain.ErrAlreadyExists) {
+			t.Errorf("Unexpected results %+v", results)
+		}
+		if after, _ := storageService.GetAllSchemas(ctx); len(after) != len(before) {
+			t.Errorf("Expected the store to be unchanged, got %d schemas instead of %d", len(after), len(before))
+		}
+	})
+
+	t.Run("Fail on conflicts inside the batch", func(t *testing.T) {
+		_, err := storageService.ImportSchemas(ctx, items("twin", "twin"), domain.ConflictFail)
+
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("Expected ErrAlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("Skip", func(t *testing.T) {
+		results, err := storageService.ImportSchemas(ctx, items("skipped", "Schema1"), domain.ConflictSkip)
+
+		expected := []domain.ImportOutcome{domain.ImportCreated, domain.ImportSkipped}
+		if err != nil || !reflect.DeepEqual(outcomes(results), expected) {
+			t.Errorf("Expected %v, got %+v (%v)", expected, results, err)
+		}
+		if results[1].SchemaID != "0abd659f-8e41-4e72-9c6e-170be7745b00" {
+			t.Errorf("Expected the existing schema id, got %s", results[1].SchemaID)
+		}
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		results, err := storageService.ImportSchemas(ctx, items("Schema2"), domain.ConflictOverwrite)
+
+		if err != nil || results[0].Outcome != domain.ImportOverwritten {
+			t.Fatalf("Expected overwrite, got %+v (%v)", results, err)
+		}
+		stored, _ := storageService.GetSchemaByID(ctx, results[0].SchemaID)
+		if stored.AuthorID != "importer" || len(stored.Tasks) != 1 {
+			t.Errorf("Expected the schema to be overwritten, got %+v", stored)
+		}
+	})
+
+	t.Run("Rename", func(t *testing.T) {
+		results, err := storageService.ImportSchemas(ctx, items("Schema3", "Schema3"), domain.ConflictRename)
+
+		expected := []domain.ImportOutcome{domain.ImportRenamed, domain.ImportRenamed}
+		if err != nil || !reflect.DeepEqual(outcomes(results), expected) {
+			t.Fatalf("Expected %v, got %+v (%v)", expected, results, err)
+		}
+		if results[0].SchemaName != "Schema3 (2)" || results[1].SchemaName != "Schema3 (3)" {
+			t.Errorf("Expected free names, got %s and %s", results[0].SchemaName, results[1].SchemaName)
+		}
+	})
+}
+
+func TestExportSchemas(t *testing.T) {
+	storageService, err := storage.NewStorage("./test_storage.json", true)
+	if err != nil {
+		t.Errorf("Failed to create storage: %v", err)
+	}
+
+	t.Run("Whole store", func(t *testing.T) {
+		snapshot, err := storageService.ExportSchemas(ctx, domain.ExportFilter{})
+		all, _ := storageService.GetAllSchemas(ctx)
+
+		if err != nil || len(snapshot.Schemas) != len(all) || snapshot.Revision != 3 {
+			t.Errorf("Expected %d schemas at revision 3, got %d at %d (%v)", len(all), len(snapshot.Schemas), snapshot.Revision, err)
+		}
+		for i := 1; i < len(snapshot.Schemas); i++ {
+			if snapshot.Schemas[i].CreatedAt.Before(snapshot.Schemas[i-1].CreatedAt) {
+				t.Errorf("Expected creation order, got %+v", snapshot.Schemas)
+			}
+		}
+	})
+
+	t.Run("Filtered", func(t *testing.T) {
+		filter := domain.ExportFilter{NamePrefix: "Schema", SchemaIDs: []string{"dd19b4f7-a4be-4ec8-a48b-be6c4f769b4b"}}
+		snapshot, _ := storageService.ExportSchemas(ctx, filter)
+
+		if len(snapshot.Schemas) != 1 || snapshot.Schemas[0].SchemaName != "Schema2" {
+			t.Errorf("Expected only Schema2, got %+v", snapshot.Schemas)
+		}
+	})
+
+	t.Run("Unaffected by later changes", func(t *testing.T) {
+		snapshot, _ := storageService.ExportSchemas(ctx, domain.ExportFilter{})
+		storageService.DeleteSchemaByID(ctx, snapshot.Schemas[0].SchemaID)
+		storageService.CreateSchema(ctx, "authorID", "exportedLater", nil)
+
+		for _, schema := range snapshot.Schemas {
+			if schema.SchemaName == "exportedLater" {
+				t.Errorf("Expected the snapshot to be unchanged")
+			}
+		}
+		if _, err := storageService.GetSchemaByID(ctx, snapshot.Schemas[0].SchemaID); err == nil {
+			t.Errorf("Expected the schema to be deleted from the store")
+		}
+	})
+}
+
+func TestCanceledContext(t *testing.T) {
+	storageService, err := storage.NewStorage("./test_storage.json", true)
+	if err != nil {
+		t.Errorf("Failed to create storage: %v", err)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	t.Run("Reads", func(t *testing.T) {
+		_, err := storageService.GetAllSchemas(canceled)
+
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	})
+
+	t.Run("Writes", func(t *testing.T) {
+		_, err := storageService.CreateSchema(canceled, "authorID", "neverCreated", nil)
+
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+		if schemas, _ := storageService.SearchSchemas(ctx, "neverCreated", 10); len(schemas) != 0 {
+			t.Errorf("Expected no schema to be created, got %+v", schemas)
+		}
+	})
+
+	t.Run("Watch ends with the call", func(t *testing.T) {
+		watchCtx, cancelWatch := context.WithCancel(ctx)
+		stream, err := storageService.WatchSchemas(watchCtx, 0, domain.EventFilter{})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		cancelWatch()
+
+		if _, ok := <-stream.Events(); ok {
+			t.Errorf("Expected the event feed to be closed")
+		}
+	})
+}
+
+func TestReady(t *testing.T) {
+	dir := t.TempDir()
+	storageService, err := storage.NewStorage(dir+"/storage.json", false)
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+
+	t.Run("Writable", func(t *testing.T) {
+		if err := storageService.Ready(); err != nil {
+			t.Errorf("Expected storage to be ready, got %v", err)
+		}
+	})
+
+	t.Run("File removed", func(t *testing.T) {
+		os.RemoveAll(dir)
+
+		if err := storageService.Ready(); err == nil {
+			t.Errorf("Expected storage not to be ready")
+		}
+	})
+}
+
+func TestLookups(t *testing.T) {
+	storageService, err := storage.NewStorage("./test_storage.json", true)
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+
+	t.Run("By name", func(t *testing.T) {
+		schema, err := storageService.GetSchemaByName(ctx, "Schema2")
+
+		if err != nil || schema.SchemaID != "dd19b4f7-a4be-4ec8-a48b-be6c4f769b4b" {
+			t.Errorf("Expected Schema2, got %+v (%v)", schema, err)
+		}
+		if _, err := storageService.GetSchemaByName(ctx, "schema2"); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected names to be case-sensitive, got %v", err)
+		}
+	})
+
+	t.Run("By author", func(t *testing.T) {
+		schemas, err := storageService.GetSchemasByAuthor(ctx, "Author1")
+
+		if err != nil || len(schemas) != 2 || schemas[0].CreatedAt.After(schemas[1].CreatedAt) {
+			t.Errorf("Expected the 2 schemas of Author1 in creation order, got %+v (%v)", schemas, err)
+		}
+		if schemas, err := storageService.GetSchemasByAuthor(ctx, "Nobody"); err != nil || len(schemas) != 0 {
+			t.Errorf("Expected no schemas, got %+v (%v)", schemas, err)
+		}
+	})
+}
+
+func TestSlugs(t *testing.T) {
+	storageService, err := storage.NewStorage("./test_storage.json", true)
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+	const schema2ID = "dd19b4f7-a4be-4ec8-a48b-be6c4f769b4b"
+
+	t.Run("Stored schemas get a slug", func(t *testing.T) {
+		schema, alias, err := storageService.GetSchemaBySlug(ctx, "schema2")
+
+		if err != nil || alias || schema.SchemaID != schema2ID || schema.Slug != "schema2" {
+			t.Errorf("Expected Schema2, got %+v %v (%v)", schema, alias, err)
+		}
+	})
+
+	t.Run("Former slugs resolve after a rename", func(t *testing.T) {
+		renamed, err := storageService.RenameSchema(ctx, schema2ID, "Sepsis Protocol (v2)")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if renamed.Slug != "sepsis-protocol-v2" || !reflect.DeepEqual(renamed.SlugAliases, []string{"schema2"}) {
+			t.Errorf("Expected the new slug and the former one as alias, got %+v", renamed)
+		}
+
+		schema, alias, err := storageService.GetSchemaBySlug(ctx, "schema2")
+		if err != nil || !alias || schema.SchemaName != "Sepsis Protocol (v2)" {
+			t.Errorf("Expected the renamed schema through its alias, got %+v %v (%v)", schema, alias, err)
+		}
+	})
+
+	t.Run("Aliases are not reused", func(t *testing.T) {
+		created, err := storageService.CreateSchema(ctx, "authorID", "Schema2", []domain.Task{})
+
+		if err != nil || created.Slug != "schema2-2" {
+			t.Errorf("Expected the slug schema2-2, got %+v (%v)", created, err)
+		}
+	})
+
+	t.Run("Renaming back reclaims the slug", func(t *testing.T) {
+		if _, err := storageService.RenameSchema(ctx, schema2ID, "schema2"); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		schema, alias, err := storageService.GetSchemaBySlug(ctx, "schema2")
+		if err != nil || alias || !reflect.DeepEqual(schema.SlugAliases, []string{"sepsis-protocol-v2"}) {
+			t.Errorf("Expected schema2 to be the current slug again, got %+v %v (%v)", schema, alias, err)
+		}
+	})
+
+	t.Run("Accented names fold to ASCII", func(t *testing.T) {
+		for name, slug := range map[string]string{"Évaluation gériatrique": "evaluation-geriatrique", "Señal Ünïcode": "senal-unicode", "Протокол": "schema"} {
+			created, err := storageService.CreateSchema(ctx, "authorID", name, []domain.Task{})
+
+			if err != nil || created.Slug != slug {
+				t.Errorf("Expected the slug %s for %s, got %+v (%v)", slug, name, created, err)
+			}
+		}
+	})
+
+	t.Run("Cannot rename to a used name", func(t *testing.T) {
+		_, err := storageService.RenameSchema(ctx, schema2ID, "Schema1")
+
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("Expected ErrAlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("Deleted schemas free their slugs", func(t *testing.T) {
+		if err := storageService.DeleteSchemaByID(ctx, schema2ID); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		for _, slug := range []string{"schema2", "sepsis-protocol-v2"} {
+			if _, _, err := storageService.GetSchemaBySlug(ctx, slug); !errors.Is(err, domain.ErrNotFound) {
+				t.Errorf("Expected ErrNotFound for %s, got %v", slug, err)
+			}
+		}
+	})
+}
+
+func TestTaskStatusMigration(t *testing.T) {
+	path := t.TempDir() + "/storage.json"
+	legacy := `[{"schema_id": "legacy", "author_id": "authorID", "schema_name": "legacy", "revision": 1, "slug": "legacy", "tasks": [
+		{"id": 1, "status": "done", "children": [
+			{"id": 2, "status": "TASK_STATUS_IN_PROGRESS"},
+			{"id": 3, "status": "UNSPECIFIED"}
+		]},
+		{"id": 4, "status": "BLOCKED"},
+		{"id": 5, "status": "in progress"}
+	]}]`
+	if err := os.WriteFile(path, []byte(legacy), 0644); err != nil {
+		t.Fatalf("Failed to write storage file: %v", err)
+	}
+
+	storageService, err := storage.NewStorage(path, false)
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+
+	schema, err := storageService.GetSchemaByID(ctx, "legacy")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	tasks := schema.Tasks
+	got := []domain.TaskStatus{tasks[0].Status, tasks[0].Children[0].Status, tasks[0].Children[1].Status, tasks[1].Status, tasks[2].Status}
+	expected := []domain.TaskStatus{domain.TaskDone, domain.TaskInProgress, domain.TaskNotStarted, domain.TaskBlocked, domain.TaskInProgress}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+
+	if schema.State != domain.StateDraft {
+		t.Errorf("Expected the schema to become a draft, got %s", schema.State)
+	}
+
+	// The migrated file is saved and reads back with the strict parsing
+	if _, err := storage.NewStorage(path, false); err != nil {
+		t.Errorf("Failed to read the migrated storage: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	if strings.Contains(string(data), "UNSPECIFIED") {
+		t.Errorf("Expected the legacy statuses to be rewritten, got %s", data)
+	}
+
+	// The file as it was is kept next to it
+	backups, _ := filepath.Glob(path + ".*.bak")
+	if len(backups) != 1 {
+		t.Fatalf("Expected one backup, got %v", backups)
+	}
+	if backup, _ := os.ReadFile(backups[0]); string(backup) != legacy {
+		t.Errorf("Expected the backup to hold the original file, got %s", backup)
+	}
+}
+
+func TestUnknownTaskStatus(t *testing.T) {
+	path := t.TempDir() + "/storage.json"
+	legacy := `[{"schema_id": "legacy", "author_id": "authorID", "schema_name": "legacy", "revision": 1, "slug": "legacy", "tasks": [
+		{"id": 1, "status": "done", "children": [{"id": 2, "status": "COMPLETED"}]}
+	]}]`
+	if err := os.WriteFile(path, []byte(legacy), 0644); err != nil {
+		t.Fatalf("Failed to write storage file: %v", err)
+	}
+
+	_, err := storage.NewStorage(path, false)
+
+	if err == nil || !strings.Contains(err.Error(), `task 2: unknown status "COMPLETED"`) {
+		t.Errorf("Expected the unknown status to fail loading, got %v", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != legacy {
+		t.Errorf("Expected the file to be left as it was, got %s", data)
+	}
+}
+
+func TestVersions(t *testing.T) {
+	storageService, err := storage.NewStorage("./test_storage.json", true)
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+
+	tasks := []domain.Task{{ID: 1, Level: 1, Name: "Task 1", Status: domain.TaskNotStarted}}
+	schema, err := storageService.CreateSchema(ctx, "authorID", "versioned", tasks)
+	if err != nil {
+		t.Fatalf("Failed to create schema: %v", err)
+	}
+
+	t.Run("Publish the draft", func(t *testing.T) {
+		version, err := storageService.PublishSchema(ctx, schema.SchemaID)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if version.Version != 1 || version.SchemaName != "versioned" || version.Revision != schema.Revision || !reflect.DeepEqual(version.Tasks, tasks) {
+			t.Errorf("Unexpected version %+v", version)
+		}
+		stored, _ := storageService.GetSchemaByID(ctx, schema.SchemaID)
+		if stored.LatestVersion() != 1 || stored.Revision <= schema.Revision {
+			t.Errorf("Expected the schema to record version 1 in a new revision, got %+v", stored)
+		}
+	})
+
+	t.Run("Already published", func(t *testing.T) {
+		_, err := storageService.PublishSchema(ctx, schema.SchemaID)
+
+		var domainErr *domain.Error
+		if !errors.As(err, &domainErr) || !errors.Is(err, domain.ErrFailedPrecondition) || domainErr.Reason != "INVALID_TRANSITION" {
+			t.Errorf("Expected INVALID_TRANSITION, got %v", err)
+		}
+	})
+
+	t.Run("Unchanged draft", func(t *testing.T) {
+		if _, err := storageService.TransitionSchema(ctx, schema.SchemaID, domain.StateDraft, ""); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		version, err := storageService.PublishSchema(ctx, schema.SchemaID)
+		if err != nil || version.Version != 1 {
+			t.Errorf("Expected version 1 to be kept, got %+v (%v)", version, err)
+		}
+	})
+
+	t.Run("Editing the draft keeps the versions", func(t *testing.T) {
+		edited := []domain.Task{{ID: 1, Level: 1, Name: "Task 1", Status: domain.TaskDone}, {ID: 2, Level: 1, Name: "Task 2", Status: domain.TaskNotStarted}}
+		updated, err := storageService.UpdateSchema(ctx, schema.SchemaID, edited, false)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !reflect.DeepEqual(updated.Tasks, edited) || updated.LatestVersion() != 1 {
+			t.Errorf("Unexpected updated schema %+v", updated)
+		}
+
+		version, err := storageService.GetSchemaVersion(ctx, schema.SchemaID, 1)
+		if err != nil || !reflect.DeepEqual(version.Tasks, tasks) {
+			t.Errorf("Expected version 1 to be unchanged, got %+v (%v)", version, err)
+		}
+	})
+
+	t.Run("Publish again", func(t *testing.T) {
+		version, err := storageService.PublishSchema(ctx, schema.SchemaID)
+		if err != nil || version.Version != 2 || len(version.Tasks) != 2 {
+			t.Fatalf("Expected version 2, got %+v (%v)", version, err)
+		}
+
+		versions, err := storageService.GetSchemaVersions(ctx, schema.SchemaID)
+		if err != nil || len(versions) != 2 || versions[0].Version != 1 || versions[1].Version != 2 {
+			t.Errorf("Expected versions 1 and 2, got %+v (%v)", versions, err)
+		}
+	})
+
+	t.Run("Version not published", func(t *testing.T) {
+		_, err := storageService.GetSchemaVersion(ctx, schema.SchemaID, 3)
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+
+	t.Run("Invalid draft", func(t *testing.T) {
+		cyclic, _ := storageService.CreateSchema(ctx, "authorID", "cyclic", []domain.Task{{ID: 1, Level: 1, Status: domain.TaskDone, BlockedBy: []int64{1}}})
+
+		_, err := storageService.PublishSchema(ctx, cyclic.SchemaID)
+
+		var domainErr *domain.Error
+		if !errors.As(err, &domainErr) || domainErr.Reason != "INVALID_DRAFT" {
+			t.Errorf("Expected INVALID_DRAFT, got %v", err)
+		}
+	})
+
+	t.Run("Schema not present", func(t *testing.T) {
+		_, err := storageService.UpdateSchema(ctx, "SchemaNotPresent", nil, false)
+
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+func TestDeletePublishedSchema(t *testing.T) {
+	path := t.TempDir() + "/storage.json"
+	storageService, err := storage.NewStorage(path, false)
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+
+	tasks := []domain.Task{{ID: 1, Level: 1, Name: "Task 1", Status: domain.TaskNotStarted}}
+	schema, _ := storageService.CreateSchema(ctx, "authorID", "published", tasks)
+	if _, err := storageService.PublishSchema(ctx, schema.SchemaID); err != nil {
+		t.Fatalf("Failed to publish schema: %v", err)
+	}
+
+	t.Run("Refused until archived", func(t *testing.T) {
+		var domainErr *domain.Error
+		err := storageService.DeleteSchemaByID(ctx, schema.SchemaID)
+		if !errors.As(err, &domainErr) || !errors.Is(err, domain.ErrFailedPrecondition) || domainErr.Reason != "SCHEMA_PUBLISHED" {
+			t.Errorf("Expected SCHEMA_PUBLISHED, got %v", err)
+		}
+
+		results, err := storageService.DeleteSchemasByIDs(ctx, []string{schema.SchemaID})
+		if err != nil || len(results) != 1 || !errors.As(results[0].Err, &domainErr) || domainErr.Reason != "SCHEMA_PUBLISHED" {
+			t.Errorf("Expected SCHEMA_PUBLISHED, got %+v (%v)", results, err)
+		}
+
+		if _, err := storageService.GetSchemaByID(ctx, schema.SchemaID); err != nil {
+			t.Errorf("Expected the schema to be kept, got %v", err)
+		}
+	})
+
+	t.Run("Archived schema keeps its versions", func(t *testing.T) {
+		for _, state := range []domain.SchemaState{domain.StateDeprecated, domain.StateArchived} {
+			if _, err := storageService.TransitionSchema(ctx, schema.SchemaID, state, ""); err != nil {
+				t.Fatalf("Failed to move schema to %s: %v", state, err)
+			}
+		}
+
+		results, err := storageService.DeleteSchemasByIDs(ctx, []string{schema.SchemaID})
+		if err != nil || len(results) != 1 || results[0].Err != nil {
+			t.Fatalf("Expected the schema to be deleted, got %+v (%v)", results, err)
+		}
+
+		reloaded, err := storage.NewStorage(path, false)
+		if err != nil {
+			t.Fatalf("Failed to reload storage: %v", err)
+		}
+		for _, s := range []*storage.Storage{storageService, reloaded} {
+			if _, err := s.GetSchemaByID(ctx, schema.SchemaID); !errors.Is(err, domain.ErrNotFound) {
+				t.Errorf("Expected ErrNotFound, got %v", err)
+			}
+			version, err := s.GetSchemaVersion(ctx, schema.SchemaID, 1)
+			if err != nil || !reflect.DeepEqual(version.Tasks, tasks) {
+				t.Errorf("Expected version 1 to resolve, got %+v (%v)", version, err)
+			}
+			if versions, err := s.GetSchemaVersions(ctx, schema.SchemaID); err != nil || len(versions) != 1 {
+				t.Errorf("Expected 1 version, got %+v (%v)", versions, err)
+			}
+		}
+	})
+
+	t.Run("Unpublished schema", func(t *testing.T) {
+		draft, _ := storageService.CreateSchema(ctx, "authorID", "draft", tasks)
+
+		if err := storageService.DeleteSchemaByID(ctx, draft.SchemaID); err != nil {
+			t.Errorf("Expected no error, got %v", err)
+		}
+		if _, err := storageService.GetSchemaVersions(ctx, draft.SchemaID); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+	})
+}
+
+func TestLifecycle(t *testing.T) {
+	storageService, err := storage.NewStorage("./test_storage.json", true)
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+
+	tasks := []domain.Task{{ID: 1, Level: 1, Name: "Task 1", Status: domain.TaskNotStarted}}
+	schema, err := storageService.CreateSchema(ctx, "authorID", "lifecycle", tasks)
+	if err != nil {
+		t.Fatalf("Failed to create schema: %v", err)
+	}
+	callerCtx := domain.WithRequestInfo(ctx, domain.RequestInfo{RequestID: "request", CallerID: "reviewer"})
+
+	t.Run("Created as draft", func(t *testing.T) {
+		if schema.State != domain.StateDraft || len(schema.StateHistory) != 0 {
+			t.Errorf("Expected a draft without history, got %+v", schema)
+		}
+	})
+
+	t.Run("Invalid transition", func(t *testing.T) {
+		_, err := storageService.TransitionSchema(ctx, schema.SchemaID, domain.StateDeprecated, "")
+
+		if !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected ErrFailedPrecondition, got %v", err)
+		}
+	})
+
+	t.Run("Review and publish", func(t *testing.T) {
+		for _, state := range []domain.SchemaState{domain.StateInReview, domain.StateApproved, domain.StatePublished} {
+			if _, err := storageService.TransitionSchema(callerCtx, schema.SchemaID, state, "looks good"); err != nil {
+				t.Fatalf("Failed to move to %s: %v", state, err)
+			}
+		}
+
+		stored, _ := storageService.GetSchemaByID(ctx, schema.SchemaID)
+		if stored.State != domain.StatePublished || stored.LatestVersion() != 1 || len(stored.StateHistory) != 3 {
+			t.Fatalf("Expected the schema to be published as version 1, got %+v", stored)
+		}
+		change := stored.StateHistory[2]
+		if change.From != domain.StateApproved || change.To != domain.StatePublished || change.Actor != "reviewer" || change.Comment != "looks good" || change.Time.IsZero() {
+			t.Errorf("Unexpected state change %+v", change)
+		}
+	})
+
+	t.Run("Editing keeps the schema published", func(t *testing.T) {
+		edited := []domain.Task{{ID: 1, Level: 1, Name: "Task 1 edited", Status: domain.TaskNotStarted}}
+		updated, err := storageService.UpdateSchema(callerCtx, schema.SchemaID, edited, false)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if updated.State != domain.StatePublished || !updated.DraftChanged() || len(updated.StateHistory) != 3 {
+			t.Errorf("Expected the schema to stay published with a changed draft, got %+v", updated)
+		}
+		if version, _ := storageService.GetSchemaVersion(ctx, schema.SchemaID, 1); !reflect.DeepEqual(version.Tasks, tasks) {
+			t.Errorf("Expected version 1 to be unchanged, got %+v", version)
+		}
+	})
+
+	t.Run("Filter by state", func(t *testing.T) {
+		snapshot, err := storageService.ExportSchemas(ctx, domain.ExportFilter{States: []domain.SchemaState{domain.StatePublished}, NamePrefix: "life"})
+
+		if err != nil || len(snapshot.Schemas) != 1 || snapshot.Schemas[0].SchemaID != schema.SchemaID {
+			t.Errorf("Expected the published schema, got %+v (%v)", snapshot.Schemas, err)
+		}
+	})
+
+	t.Run("Publish the changes", func(t *testing.T) {
+		version, err := storageService.PublishSchema(ctx, schema.SchemaID)
+		if err != nil || version.Version != 2 || version.Tasks[0].Name != "Task 1 edited" {
+			t.Fatalf("Expected version 2, got %+v (%v)", version, err)
+		}
+
+		stored, _ := storageService.GetSchemaByID(ctx, schema.SchemaID)
+		last := stored.StateHistory[len(stored.StateHistory)-1]
+		if stored.State != domain.StatePublished || stored.DraftChanged() || last.From != domain.StatePublished || last.To != domain.StatePublished {
+			t.Errorf("Expected the changes to be published, got %+v", stored)
+		}
+		if _, err := storageService.PublishSchema(ctx, schema.SchemaID); !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected an unchanged published schema not to be published again, got %v", err)
+		}
+	})
+
+	t.Run("Editing an approved schema reopens the draft", func(t *testing.T) {
+		approved, _ := storageService.CreateSchema(ctx, "authorID", "approved", tasks)
+		for _, state := range []domain.SchemaState{domain.StateInReview, domain.StateApproved} {
+			if _, err := storageService.TransitionSchema(callerCtx, approved.SchemaID, state, ""); err != nil {
+				t.Fatalf("Failed to move to %s: %v", state, err)
+			}
+		}
+
+		updated, err := storageService.RenameSchema(callerCtx, approved.SchemaID, "approved and renamed")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		last := updated.StateHistory[len(updated.StateHistory)-1]
+		if updated.State != domain.StateDraft || last.From != domain.StateApproved || last.Actor != "reviewer" {
+			t.Errorf("Expected the schema to be a draft again, got %+v", updated)
+		}
+	})
+
+	t.Run("Archived schemas cannot be edited", func(t *testing.T) {
+		for _, state := range []domain.SchemaState{domain.StateDeprecated, domain.StateArchived} {
+			if _, err := storageService.TransitionSchema(ctx, schema.SchemaID, state, ""); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		}
+
+		_, err := storageService.UpdateSchema(ctx, schema.SchemaID, tasks, false)
+
+		var domainErr *domain.Error
+		if !errors.As(err, &domainErr) || domainErr.Reason != "SCHEMA_NOT_EDITABLE" {
+			t.Errorf("Expected SCHEMA_NOT_EDITABLE, got %v", err)
+		}
+		if _, err := storageService.TransitionSchema(ctx, schema.SchemaID, domain.StateDraft, ""); !errors.Is(err, domain.ErrFailedPrecondition) {
+			t.Errorf("Expected archived schemas to stay archived, got %v", err)
+		}
+	})
+}
+
+func TestReviews(t *testing.T) {
+	storageService, err := storage.NewStorage("./test_storage.json", true)
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+	storageService.SetReviewPolicy(domain.ReviewPolicy{MinApprovals: 2, RequiredRoles: []string{"medical_lead", "pharmacist"}})
+
+	tasks := []domain.Task{{ID: 1, Level: 1, Name: "Task 1", Status: domain.TaskNotStarted}}
+	schema, err := storageService.CreateSchema(ctx, "author", "reviewed", tasks)
+	if err != nil {
+		t.Fatalf("Failed to create schema: %v", err)
+	}
+	as := func(caller string, roles ...string) context.Context {
+		return domain.WithRequestInfo(ctx, domain.RequestInfo{RequestID: "request", CallerID: caller, CallerRoles: roles})
+	}
+	reason := func(err error) string {
+		var domainErr *domain.Error
+		if errors.As(err, &domainErr) {
+			return domainErr.Reason
+		}
+		return ""
+	}
+
+	t.Run("Publishing requires a review", func(t *testing.T) {
+		_, err := storageService.PublishSchema(ctx, schema.SchemaID)
+
+		if reason(err) != "REVIEW_REQUIRED" {
+			t.Errorf("Expected REVIEW_REQUIRED, got %v", err)
+		}
+	})
+
+	t.Run("Not in review", func(t *testing.T) {
+		_, err := storageService.ReviewSchema(as("lead", "medical_lead"), schema.SchemaID, domain.DecisionApprove, "")
+
+		if reason(err) != "NOT_IN_REVIEW" {
+			t.Errorf("Expected NOT_IN_REVIEW, got %v", err)
+		}
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		if _, err := storageService.TransitionSchema(as("author"), schema.SchemaID, domain.StateInReview, "first try"); err != nil {
+			t.Fatalf("Failed to request review: %v", err)
+		}
+
+		rejected, err := storageService.ReviewSchema(as("pharma", "pharmacist"), schema.SchemaID, domain.DecisionReject, "wrong dose")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		review := rejected.Reviews[0]
+		if rejected.State != domain.StateDraft || review.Status != domain.ReviewRejected || review.RequestedBy != "author" ||
+			len(review.Records) != 1 || review.Records[0].Comment != "wrong dose" || review.Records[0].Roles[0] != "pharmacist" {
+			t.Errorf("Expected a rejected review, got %+v", rejected)
+		}
+	})
+
+	t.Run("Approved once the policy is satisfied", func(t *testing.T) {
+		if _, err := storageService.TransitionSchema(as("author"), schema.SchemaID, domain.StateInReview, ""); err != nil {
+			t.Fatalf("Failed to request review: %v", err)
+		}
+
+		if _, err := storageService.ReviewSchema(as("author", "medical_lead"), schema.SchemaID, domain.DecisionApprove, ""); reason(err) != "SELF_APPROVAL" {
+			t.Errorf("Expected SELF_APPROVAL, got %v", err)
+		}
+		if _, err := storageService.ReviewSchema(ctx, schema.SchemaID, domain.DecisionApprove, ""); !errors.Is(err, domain.ErrPermissionDenied) {
+			t.Errorf("Expected anonymous callers to be refused, got %v", err)
+		}
+
+		inReview, err := storageService.ReviewSchema(as("lead", "medical_lead"), schema.SchemaID, domain.DecisionApprove, "")
+		if err != nil || inReview.State != domain.StateInReview {
+			t.Fatalf("Expected the schema to stay in review, got %+v (%v)", inReview, err)
+		}
+		if _, err := storageService.ReviewSchema(as("lead", "medical_lead"), schema.SchemaID, domain.DecisionApprove, ""); reason(err) != "ALREADY_REVIEWED" {
+			t.Errorf("Expected ALREADY_REVIEWED, got %v", err)
+		}
+		if _, err := storageService.TransitionSchema(ctx, schema.SchemaID, domain.StateApproved, ""); reason(err) != "REVIEW_POLICY_NOT_SATISFIED" {
+			t.Errorf("Expected REVIEW_POLICY_NOT_SATISFIED, got %v", err)
+		}
+
+		approved, err := storageService.ReviewSchema(as("pharma", "pharmacist"), schema.SchemaID, domain.DecisionApprove, "ok")
+		if err != nil || approved.State != domain.StateApproved || approved.Reviews[1].Status != domain.ReviewApproved || len(approved.Reviews) != 2 {
+			t.Fatalf("Expected the schema to be approved, got %+v (%v)", approved, err)
+		}
+
+		version, err := storageService.PublishSchema(ctx, schema.SchemaID)
+		if err != nil || version.Version != 1 {
+			t.Errorf("Expected version 1, got %+v (%v)", version, err)
+		}
+	})
+
+	t.Run("Changes to a published schema need review", func(t *testing.T) {
+		edited := []domain.Task{{ID: 1, Level: 1, Name: "Task 1 edited", Status: domain.TaskNotStarted}}
+		if _, err := storageService.UpdateSchema(ctx, schema.SchemaID, edited, false); err != nil {
+			t.Fatalf("Failed to edit the draft: %v", err)
+		}
+
+		if _, err := storageService.PublishSchema(ctx, schema.SchemaID); reason(err) != "REVIEW_REQUIRED" {
+			t.Errorf("Expected REVIEW_REQUIRED, got %v", err)
+		}
+	})
+
+	t.Run("Editing withdraws the review", func(t *testing.T) {
+		if _, err := storageService.TransitionSchema(as("author"), schema.SchemaID, domain.StateDraft, ""); err != nil {
+			t.Fatalf("Failed to reopen the draft: %v", err)
+		}
+		if _, err := storageService.TransitionSchema(as("author"), schema.SchemaID, domain.StateInReview, ""); err != nil {
+			t.Fatalf("Failed to request review: %v", err)
+		}
+
+		updated, err := storageService.UpdateSchema(ctx, schema.SchemaID, tasks, false)
+		if err != nil || updated.State != domain.StateDraft || updated.Reviews[2].Status != domain.ReviewWithdrawn || len(updated.Reviews) != 3 {
+			t.Errorf("Expected the review to be withdrawn, got %+v (%v)", updated, err)
+		}
+	})
+}
@@ -26,6 +26,29 @@ type SchemaServiceClient interface {
 	GetAllSchemas(ctx context.Context, in *GetAllSchemasRequest, opts ...grpc.CallOption) (*GetAllSchemasResponse, error)
 	GetSchemaByID(ctx context.Context, in *GetSchemaByIDRequest, opts ...grpc.CallOption) (*GetSchemaByIDResponse, error)
 	DeleteSchemaByID(ctx context.Context, in *DeleteSchemaByIDRequest, opts ...grpc.CallOption) (*DeleteSchemaByIDResponse, error)
+	SearchSchemas(ctx context.Context, in *SearchSchemasRequest, opts ...grpc.CallOption) (*SearchSchemasResponse, error)
+	BatchGetSchemas(ctx context.Context, in *BatchGetSchemasRequest, opts ...grpc.CallOption) (*BatchGetSchemasResponse, error)
+	BatchDeleteSchemas(ctx context.Context, in *BatchDeleteSchemasRequest, opts ...grpc.CallOption) (*BatchDeleteSchemasResponse, error)
+	WatchSchemas(ctx context.Context, in *WatchSchemasRequest, opts ...grpc.CallOption) (SchemaService_WatchSchemasClient, error)
+	GetChangesSince(ctx context.Context, in *GetChangesSinceRequest, opts ...grpc.CallOption) (*GetChangesSinceResponse, error)
+	CloneSchema(ctx context.Context, in *CloneSchemaRequest, opts ...grpc.CallOption) (*CloneSchemaResponse, error)
+	ListDerivedSchemas(ctx context.Context, in *ListDerivedSchemasRequest, opts ...grpc.CallOption) (*ListDerivedSchemasResponse, error)
+	ValidateSchema(ctx context.Context, in *ValidateSchemaRequest, opts ...grpc.CallOption) (*ValidateSchemaResponse, error)
+	ImportSchemas(ctx context.Context, opts ...grpc.CallOption) (SchemaService_ImportSchemasClient, error)
+	ExportSchemas(ctx context.Context, in *ExportSchemasRequest, opts ...grpc.CallOption) (SchemaService_ExportSchemasClient, error)
+	GetSchemaByName(ctx context.Context, in *GetSchemaByNameRequest, opts ...grpc.CallOption) (*GetSchemaByNameResponse, error)
+	ListSchemasByAuthor(ctx context.Context, in *ListSchemasByAuthorRequest, opts ...grpc.CallOption) (*ListSchemasByAuthorResponse, error)
+	GetSchemaBySlug(ctx context.Context, in *GetSchemaBySlugRequest, opts ...grpc.CallOption) (*GetSchemaBySlugResponse, error)
+	RenameSchema(ctx context.Context, in *RenameSchemaRequest, opts ...grpc.CallOption) (*RenameSchemaResponse, error)
+	UpdateSchema(ctx context.Context, in *UpdateSchemaRequest, opts ...grpc.CallOption) (*UpdateSchemaResponse, error)
+	PublishSchema(ctx context.Context, in *PublishSchemaRequest, opts ...grpc.CallOption) (*PublishSchemaResponse, error)
+	ListSchemaVersions(ctx context.Context, in *ListSchemaVersionsRequest, opts ...grpc.CallOption) (*ListSchemaVersionsResponse, error)
+	GetSchemaVersion(ctx context.Context, in *GetSchemaVersionRequest, opts ...grpc.CallOption) (*GetSchemaVersionResponse, error)
+	TransitionSchema(ctx context.Context, in *TransitionSchemaRequest, opts ...grpc.CallOption) (*TransitionSchemaResponse, error)
+	RequestSchemaReview(ctx context.Context, in *RequestSchemaReviewRequest, opts ...grpc.CallOption) (*RequestSchemaReviewResponse, error)
+	ApproveSchema(ctx context.Context, in *ApproveSchemaRequest, opts ...grpc.CallOption) (*ApproveSchemaResponse, error)
+	RejectSchema(ctx context.Context, in *RejectSchemaRequest, opts ...grpc.CallOption) (*RejectSchemaResponse, error)
+	DiffSchemas(ctx context.Context, in *DiffSchemasRequest, opts ...grpc.CallOption) (*DiffSchemasResponse, error)
 }
 
 type schemaServiceClient struct {
@@ -72,6 +95,284 @@ func (c *schemaServiceClient) DeleteSchemaByID(ctx context.Context, in *DeleteSc
 	return out, nil
 }
 
+func (c *schemaServiceClient) SearchSchemas(ctx context.Context, in *SearchSchemasRequest, opts ...grpc.CallOption) (*SearchSchemasResponse, error) {
+	out := new(SearchSchemasResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/SearchSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) BatchGetSchemas(ctx context.Context, in *BatchGetSchemasRequest, opts ...grpc.CallOption) (*BatchGetSchemasResponse, error) {
+	out := new(BatchGetSchemasResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/BatchGetSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) BatchDeleteSchemas(ctx context.Context, in *BatchDeleteSchemasRequest, opts ...grpc.CallOption) (*BatchDeleteSchemasResponse, error) {
+	out := new(BatchDeleteSchemasResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/BatchDeleteSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) WatchSchemas(ctx context.Context, in *WatchSchemasRequest, opts ...grpc.CallOption) (SchemaService_WatchSchemasClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SchemaService_ServiceDesc.Streams[0], "/alt_team.schema_service.SchemaService/WatchSchemas", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &schemaServiceWatchSchemasClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SchemaService_WatchSchemasClient interface {
+	Recv() (*WatchSchemasResponse, error)
+	grpc.ClientStream
+}
+
+type schemaServiceWatchSchemasClient struct {
+	grpc.ClientStream
+}
+
+func (x *schemaServiceWatchSchemasClient) Recv() (*WatchSchemasResponse, error) {
+	m := new(WatchSchemasResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *schemaServiceClient) GetChangesSince(ctx context.Context, in *GetChangesSinceRequest, opts ...grpc.CallOption) (*GetChangesSinceResponse, error) {
+	out := new(GetChangesSinceResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/GetChangesSince", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) CloneSchema(ctx context.Context, in *CloneSchemaRequest, opts ...grpc.CallOption) (*CloneSchemaResponse, error) {
+	out := new(CloneSchemaResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/CloneSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) ListDerivedSchemas(ctx context.Context, in *ListDerivedSchemasRequest, opts ...grpc.CallOption) (*ListDerivedSchemasResponse, error) {
+	out := new(ListDerivedSchemasResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/ListDerivedSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) ValidateSchema(ctx context.Context, in *ValidateSchemaRequest, opts ...grpc.CallOption) (*ValidateSchemaResponse, error) {
+	out := new(ValidateSchemaResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/ValidateSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) ImportSchemas(ctx context.Context, opts ...grpc.CallOption) (SchemaService_ImportSchemasClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SchemaService_ServiceDesc.Streams[1], "/alt_team.schema_service.SchemaService/ImportSchemas", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &schemaServiceImportSchemasClient{stream}
+	return x, nil
+}
+
+type SchemaService_ImportSchemasClient interface {
+	Send(*ImportSchemasRequest) error
+	CloseAndRecv() (*ImportSchemasResponse, error)
+	grpc.ClientStream
+}
+
+type schemaServiceImportSchemasClient struct {
+	grpc.ClientStream
+}
+
+func (x *schemaServiceImportSchemasClient) Send(m *ImportSchemasRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *schemaServiceImportSchemasClient) CloseAndRecv() (*ImportSchemasResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportSchemasResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *schemaServiceClient) ExportSchemas(ctx context.Context, in *ExportSchemasRequest, opts ...grpc.CallOption) (SchemaService_ExportSchemasClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SchemaService_ServiceDesc.Streams[2], "/alt_team.schema_service.SchemaService/ExportSchemas", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &schemaServiceExportSchemasClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SchemaService_ExportSchemasClient interface {
+	Recv() (*ExportSchemasResponse, error)
+	grpc.ClientStream
+}
+
+type schemaServiceExportSchemasClient struct {
+	grpc.ClientStream
+}
+
+func (x *schemaServiceExportSchemasClient) Recv() (*ExportSchemasResponse, error) {
+	m := new(ExportSchemasResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *schemaServiceClient) GetSchemaByName(ctx context.Context, in *GetSchemaByNameRequest, opts ...grpc.CallOption) (*GetSchemaByNameResponse, error) {
+	out := new(GetSchemaByNameResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/GetSchemaByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) ListSchemasByAuthor(ctx context.Context, in *ListSchemasByAuthorRequest, opts ...grpc.CallOption) (*ListSchemasByAuthorResponse, error) {
+	out := new(ListSchemasByAuthorResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/ListSchemasByAuthor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) GetSchemaBySlug(ctx context.Context, in *GetSchemaBySlugRequest, opts ...grpc.CallOption) (*GetSchemaBySlugResponse, error) {
+	out := new(GetSchemaBySlugResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/GetSchemaBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) RenameSchema(ctx context.Context, in *RenameSchemaRequest, opts ...grpc.CallOption) (*RenameSchemaResponse, error) {
+	out := new(RenameSchemaResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/RenameSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) UpdateSchema(ctx context.Context, in *UpdateSchemaRequest, opts ...grpc.CallOption) (*UpdateSchemaResponse, error) {
+	out := new(UpdateSchemaResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/UpdateSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) PublishSchema(ctx context.Context, in *PublishSchemaRequest, opts ...grpc.CallOption) (*PublishSchemaResponse, error) {
+	out := new(PublishSchemaResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/PublishSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) ListSchemaVersions(ctx context.Context, in *ListSchemaVersionsRequest, opts ...grpc.CallOption) (*ListSchemaVersionsResponse, error) {
+	out := new(ListSchemaVersionsResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/ListSchemaVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) GetSchemaVersion(ctx context.Context, in *GetSchemaVersionRequest, opts ...grpc.CallOption) (*GetSchemaVersionResponse, error) {
+	out := new(GetSchemaVersionResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/GetSchemaVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) TransitionSchema(ctx context.Context, in *TransitionSchemaRequest, opts ...grpc.CallOption) (*TransitionSchemaResponse, error) {
+	out := new(TransitionSchemaResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/TransitionSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) RequestSchemaReview(ctx context.Context, in *RequestSchemaReviewRequest, opts ...grpc.CallOption) (*RequestSchemaReviewResponse, error) {
+	out := new(RequestSchemaReviewResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/RequestSchemaReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) ApproveSchema(ctx context.Context, in *ApproveSchemaRequest, opts ...grpc.CallOption) (*ApproveSchemaResponse, error) {
+	out := new(ApproveSchemaResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/ApproveSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) RejectSchema(ctx context.Context, in *RejectSchemaRequest, opts ...grpc.CallOption) (*RejectSchemaResponse, error) {
+	out := new(RejectSchemaResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/RejectSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schemaServiceClient) DiffSchemas(ctx context.Context, in *DiffSchemasRequest, opts ...grpc.CallOption) (*DiffSchemasResponse, error) {
+	out := new(DiffSchemasResponse)
+	err := c.cc.Invoke(ctx, "/alt_team.schema_service.SchemaService/DiffSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SchemaServiceServer is the server API for SchemaService service.
 // All implementations must embed UnimplementedSchemaServiceServer
 // for forward compatibility
@@ -80,6 +381,29 @@ type SchemaServiceServer interface {
 	GetAllSchemas(context.Context, *GetAllSchemasRequest) (*GetAllSchemasResponse, error)
 	GetSchemaByID(context.Context, *GetSchemaByIDRequest) (*GetSchemaByIDResponse, error)
 	DeleteSchemaByID(context.Context, *DeleteSchemaByIDRequest) (*DeleteSchemaByIDResponse, error)
+	SearchSchemas(context.Context, *SearchSchemasRequest) (*SearchSchemasResponse, error)
+	BatchGetSchemas(context.Context, *BatchGetSchemasRequest) (*BatchGetSchemasResponse, error)
+	BatchDeleteSchemas(context.Context, *BatchDeleteSchemasRequest) (*BatchDeleteSchemasResponse, error)
+	WatchSchemas(*WatchSchemasRequest, SchemaService_WatchSchemasServer) error
+	GetChangesSince(context.Context, *GetChangesSinceRequest) (*GetChangesSinceResponse, error)
+	CloneSchema(context.Context, *CloneSchemaRequest) (*CloneSchemaResponse, error)
+	ListDerivedSchemas(context.Context, *ListDerivedSchemasRequest) (*ListDerivedSchemasResponse, error)
+	ValidateSchema(context.Context, *ValidateSchemaRequest) (*ValidateSchemaResponse, error)
+	ImportSchemas(SchemaService_ImportSchemasServer) error
+	ExportSchemas(*ExportSchemasRequest, SchemaService_ExportSchemasServer) error
+	GetSchemaByName(context.Context, *GetSchemaByNameRequest) (*GetSchemaByNameResponse, error)
+	ListSchemasByAuthor(context.Context, *ListSchemasByAuthorRequest) (*ListSchemasByAuthorResponse, error)
+	GetSchemaBySlug(context.Context, *GetSchemaBySlugRequest) (*GetSchemaBySlugResponse, error)
+	RenameSchema(context.Context, *RenameSchemaRequest) (*RenameSchemaResponse, error)
+	UpdateSchema(context.Context, *UpdateSchemaRequest) (*UpdateSchemaResponse, error)
+	PublishSchema(context.Context, *PublishSchemaRequest) (*PublishSchemaResponse, error)
+	ListSchemaVersions(context.Context, *ListSchemaVersionsRequest) (*ListSchemaVersionsResponse, error)
+	GetSchemaVersion(context.Context, *GetSchemaVersionRequest) (*GetSchemaVersionResponse, error)
+	TransitionSchema(context.Context, *TransitionSchemaRequest) (*TransitionSchemaResponse, error)
+	RequestSchemaReview(context.Context, *RequestSchemaReviewRequest) (*RequestSchemaReviewResponse, error)
+	ApproveSchema(context.Context, *ApproveSchemaRequest) (*ApproveSchemaResponse, error)
+	RejectSchema(context.Context, *RejectSchemaRequest) (*RejectSchemaResponse, error)
+	DiffSchemas(context.Context, *DiffSchemasRequest) (*DiffSchemasResponse, error)
 	mustEmbedUnimplementedSchemaServiceServer()
 }
 
@@ -99,6 +423,75 @@ func (UnimplementedSchemaServiceServer) GetSchemaByID(context.Context, *GetSchem
 func (UnimplementedSchemaServiceServer) DeleteSchemaByID(context.Context, *DeleteSchemaByIDRequest) (*DeleteSchemaByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchemaByID not implemented")
 }
+func (UnimplementedSchemaServiceServer) SearchSchemas(context.Context, *SearchSchemasRequest) (*SearchSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSchemas not implemented")
+}
+func (UnimplementedSchemaServiceServer) BatchGetSchemas(context.Context, *BatchGetSchemasRequest) (*BatchGetSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetSchemas not implemented")
+}
+func (UnimplementedSchemaServiceServer) BatchDeleteSchemas(context.Context, *BatchDeleteSchemasRequest) (*BatchDeleteSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteSchemas not implemented")
+}
+func (UnimplementedSchemaServiceServer) WatchSchemas(*WatchSchemasRequest, SchemaService_WatchSchemasServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSchemas not implemented")
+}
+func (UnimplementedSchemaServiceServer) GetChangesSince(context.Context, *GetChangesSinceRequest) (*GetChangesSinceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangesSince not implemented")
+}
+func (UnimplementedSchemaServiceServer) CloneSchema(context.Context, *CloneSchemaRequest) (*CloneSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneSchema not implemented")
+}
+func (UnimplementedSchemaServiceServer) ListDerivedSchemas(context.Context, *ListDerivedSchemasRequest) (*ListDerivedSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDerivedSchemas not implemented")
+}
+func (UnimplementedSchemaServiceServer) ValidateSchema(context.Context, *ValidateSchemaRequest) (*ValidateSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateSchema not implemented")
+}
+func (UnimplementedSchemaServiceServer) ImportSchemas(SchemaService_ImportSchemasServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportSchemas not implemented")
+}
+func (UnimplementedSchemaServiceServer) ExportSchemas(*ExportSchemasRequest, SchemaService_ExportSchemasServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSchemas not implemented")
+}
+func (UnimplementedSchemaServiceServer) GetSchemaByName(context.Context, *GetSchemaByNameRequest) (*GetSchemaByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaByName not implemented")
+}
+func (UnimplementedSchemaServiceServer) ListSchemasByAuthor(context.Context, *ListSchemasByAuthorRequest) (*ListSchemasByAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchemasByAuthor not implemented")
+}
+func (UnimplementedSchemaServiceServer) GetSchemaBySlug(context.Context, *GetSchemaBySlugRequest) (*GetSchemaBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaBySlug not implemented")
+}
+func (UnimplementedSchemaServiceServer) RenameSchema(context.Context, *RenameSchemaRequest) (*RenameSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameSchema not implemented")
+}
+func (UnimplementedSchemaServiceServer) UpdateSchema(context.Context, *UpdateSchemaRequest) (*UpdateSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchema not implemented")
+}
+func (UnimplementedSchemaServiceServer) PublishSchema(context.Context, *PublishSchemaRequest) (*PublishSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishSchema not implemented")
+}
+func (UnimplementedSchemaServiceServer) ListSchemaVersions(context.Context, *ListSchemaVersionsRequest) (*ListSchemaVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchemaVersions not implemented")
+}
+func (UnimplementedSchemaServiceServer) GetSchemaVersion(context.Context, *GetSchemaVersionRequest) (*GetSchemaVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchemaVersion not implemented")
+}
+func (UnimplementedSchemaServiceServer) TransitionSchema(context.Context, *TransitionSchemaRequest) (*TransitionSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransitionSchema not implemented")
+}
+func (UnimplementedSchemaServiceServer) RequestSchemaReview(context.Context, *RequestSchemaReviewRequest) (*RequestSchemaReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSchemaReview not implemented")
+}
+func (UnimplementedSchemaServiceServer) ApproveSchema(context.Context, *ApproveSchemaRequest) (*ApproveSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveSchema not implemented")
+}
+func (UnimplementedSchemaServiceServer) RejectSchema(context.Context, *RejectSchemaRequest) (*RejectSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectSchema not implemented")
+}
+func (UnimplementedSchemaServiceServer) DiffSchemas(context.Context, *DiffSchemasRequest) (*DiffSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffSchemas not implemented")
+}
 func (UnimplementedSchemaServiceServer) mustEmbedUnimplementedSchemaServiceServer() {}
 
 // UnsafeSchemaServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -184,30 +577,554 @@ func _SchemaService_DeleteSchemaByID_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-// SchemaService_ServiceDesc is the grpc.ServiceDesc for SchemaService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var SchemaService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "alt_team.schema_service.SchemaService",
-	HandlerType: (*SchemaServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateSchema",
-			Handler:    _SchemaService_CreateSchema_Handler,
-		},
-		{
-			MethodName: "GetAllSchemas",
-			Handler:    _SchemaService_GetAllSchemas_Handler,
-		},
-		{
-			MethodName: "GetSchemaByID",
-			Handler:    _SchemaService_GetSchemaByID_Handler,
-		},
-		{
-			MethodName: "DeleteSchemaByID",
-			Handler:    _SchemaService_DeleteSchemaByID_Handler,
+func _SchemaService_SearchSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).SearchSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/SearchSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).SearchSchemas(ctx, req.(*SearchSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_BatchGetSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).BatchGetSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/BatchGetSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).BatchGetSchemas(ctx, req.(*BatchGetSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_BatchDeleteSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).BatchDeleteSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/BatchDeleteSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).BatchDeleteSchemas(ctx, req.(*BatchDeleteSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_WatchSchemas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSchemasRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SchemaServiceServer).WatchSchemas(m, &schemaServiceWatchSchemasServer{stream})
+}
+
+type SchemaService_WatchSchemasServer interface {
+	Send(*WatchSchemasResponse) error
+	grpc.ServerStream
+}
+
+type schemaServiceWatchSchemasServer struct {
+	grpc.ServerStream
+}
+
+func (x *schemaServiceWatchSchemasServer) Send(m *WatchSchemasResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SchemaService_GetChangesSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangesSinceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).GetChangesSince(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/GetChangesSince",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).GetChangesSince(ctx, req.(*GetChangesSinceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_CloneSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).CloneSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/CloneSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).CloneSchema(ctx, req.(*CloneSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_ListDerivedSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDerivedSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).ListDerivedSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/ListDerivedSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).ListDerivedSchemas(ctx, req.(*ListDerivedSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_ValidateSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).ValidateSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/ValidateSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).ValidateSchema(ctx, req.(*ValidateSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_ImportSchemas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SchemaServiceServer).ImportSchemas(&schemaServiceImportSchemasServer{stream})
+}
+
+type SchemaService_ImportSchemasServer interface {
+	SendAndClose(*ImportSchemasResponse) error
+	Recv() (*ImportSchemasRequest, error)
+	grpc.ServerStream
+}
+
+type schemaServiceImportSchemasServer struct {
+	grpc.ServerStream
+}
+
+func (x *schemaServiceImportSchemasServer) SendAndClose(m *ImportSchemasResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *schemaServiceImportSchemasServer) Recv() (*ImportSchemasRequest, error) {
+	m := new(ImportSchemasRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _SchemaService_ExportSchemas_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSchemasRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SchemaServiceServer).ExportSchemas(m, &schemaServiceExportSchemasServer{stream})
+}
+
+type SchemaService_ExportSchemasServer interface {
+	Send(*ExportSchemasResponse) error
+	grpc.ServerStream
+}
+
+type schemaServiceExportSchemasServer struct {
+	grpc.ServerStream
+}
+
+func (x *schemaServiceExportSchemasServer) Send(m *ExportSchemasResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SchemaService_GetSchemaByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).GetSchemaByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/GetSchemaByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).GetSchemaByName(ctx, req.(*GetSchemaByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_ListSchemasByAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchemasByAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).ListSchemasByAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/ListSchemasByAuthor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).ListSchemasByAuthor(ctx, req.(*ListSchemasByAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_GetSchemaBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).GetSchemaBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/GetSchemaBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).GetSchemaBySlug(ctx, req.(*GetSchemaBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_RenameSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).RenameSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/RenameSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).RenameSchema(ctx, req.(*RenameSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_UpdateSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).UpdateSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/UpdateSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).UpdateSchema(ctx, req.(*UpdateSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_PublishSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).PublishSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/PublishSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).PublishSchema(ctx, req.(*PublishSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_ListSchemaVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchemaVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).ListSchemaVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/ListSchemaVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).ListSchemaVersions(ctx, req.(*ListSchemaVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_GetSchemaVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).GetSchemaVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/GetSchemaVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).GetSchemaVersion(ctx, req.(*GetSchemaVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_TransitionSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransitionSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).TransitionSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/TransitionSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).TransitionSchema(ctx, req.(*TransitionSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_RequestSchemaReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestSchemaReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).RequestSchemaReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/RequestSchemaReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).RequestSchemaReview(ctx, req.(*RequestSchemaReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_ApproveSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).ApproveSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/ApproveSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).ApproveSchema(ctx, req.(*ApproveSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_RejectSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).RejectSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/RejectSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).RejectSchema(ctx, req.(*RejectSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SchemaService_DiffSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchemaServiceServer).DiffSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alt_team.schema_service.SchemaService/DiffSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchemaServiceServer).DiffSchemas(ctx, req.(*DiffSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SchemaService_ServiceDesc is the grpc.ServiceDesc for SchemaService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SchemaService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "alt_team.schema_service.SchemaService",
+	HandlerType: (*SchemaServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSchema",
+			Handler:    _SchemaService_CreateSchema_Handler,
+		},
+		{
+			MethodName: "GetAllSchemas",
+			Handler:    _SchemaService_GetAllSchemas_Handler,
+		},
+		{
+			MethodName: "GetSchemaByID",
+			Handler:    _SchemaService_GetSchemaByID_Handler,
+		},
+		{
+			MethodName: "DeleteSchemaByID",
+			Handler:    _SchemaService_DeleteSchemaByID_Handler,
+		},
+		{
+			MethodName: "SearchSchemas",
+			Handler:    _SchemaService_SearchSchemas_Handler,
+		},
+		{
+			MethodName: "BatchGetSchemas",
+			Handler:    _SchemaService_BatchGetSchemas_Handler,
+		},
+		{
+			MethodName: "BatchDeleteSchemas",
+			Handler:    _SchemaService_BatchDeleteSchemas_Handler,
+		},
+		{
+			MethodName: "GetChangesSince",
+			Handler:    _SchemaService_GetChangesSince_Handler,
+		},
+		{
+			MethodName: "CloneSchema",
+			Handler:    _SchemaService_CloneSchema_Handler,
+		},
+		{
+			MethodName: "ListDerivedSchemas",
+			Handler:    _SchemaService_ListDerivedSchemas_Handler,
+		},
+		{
+			MethodName: "ValidateSchema",
+			Handler:    _SchemaService_ValidateSchema_Handler,
+		},
+		{
+			MethodName: "GetSchemaByName",
+			Handler:    _SchemaService_GetSchemaByName_Handler,
+		},
+		{
+			MethodName: "ListSchemasByAuthor",
+			Handler:    _SchemaService_ListSchemasByAuthor_Handler,
+		},
+		{
+			MethodName: "GetSchemaBySlug",
+			Handler:    _SchemaService_GetSchemaBySlug_Handler,
+		},
+		{
+			MethodName: "RenameSchema",
+			Handler:    _SchemaService_RenameSchema_Handler,
+		},
+		{
+			MethodName: "UpdateSchema",
+			Handler:    _SchemaService_UpdateSchema_Handler,
+		},
+		{
+			MethodName: "PublishSchema",
+			Handler:    _SchemaService_PublishSchema_Handler,
+		},
+		{
+			MethodName: "ListSchemaVersions",
+			Handler:    _SchemaService_ListSchemaVersions_Handler,
+		},
+		{
+			MethodName: "GetSchemaVersion",
+			Handler:    _SchemaService_GetSchemaVersion_Handler,
+		},
+		{
+			MethodName: "TransitionSchema",
+			Handler:    _SchemaService_TransitionSchema_Handler,
+		},
+		{
+			MethodName: "RequestSchemaReview",
+			Handler:    _SchemaService_RequestSchemaReview_Handler,
+		},
+		{
+			MethodName: "ApproveSchema",
+			Handler:    _SchemaService_ApproveSchema_Handler,
+		},
+		{
+			MethodName: "RejectSchema",
+			Handler:    _SchemaService_RejectSchema_Handler,
+		},
+		{
+			MethodName: "DiffSchemas",
+			Handler:    _SchemaService_DiffSchemas_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSchemas",
+			Handler:       _SchemaService_WatchSchemas_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportSchemas",
+			Handler:       _SchemaService_ImportSchemas_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportSchemas",
+			Handler:       _SchemaService_ExportSchemas_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/schema_service.proto",
 }